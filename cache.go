@@ -0,0 +1,275 @@
+package starr
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+/* This file contains an optional response cache for read-heavy callers. */
+
+// CacheConfig is the input data for the caching round tripper.
+// Paths in TTLs are matched against the request path by longest prefix, like "/api/v3/series".
+// A TTL of zero in the map disables caching for that path. Paths that match nothing in TTLs use the TTL member.
+// Starr apps mark their API responses as not cacheable; those headers are ignored on purpose.
+type CacheConfig struct {
+	TTL  time.Duration            // Default time to live for GET responses. 0 = only cache paths in TTLs.
+	TTLs map[string]time.Duration // Per-path TTLs. Keys are path prefixes.
+}
+
+// CacheStats contains the counters for a caching round tripper.
+type CacheStats struct {
+	Hits          int64 // Responses served from cache without contacting the app.
+	Misses        int64 // Responses that were not in the cache (or expired) and were retrieved.
+	Revalidated   int64 // Expired responses the app confirmed were unchanged (304 Not Modified).
+	Invalidations int64 // Cache entries removed because a PUT, POST or DELETE touched their resource.
+}
+
+// CachingRoundTripper caches successful GET responses in memory. Entries are removed
+// when a successful PUT, POST or DELETE is made to the same resource, like /api/v3/movie.
+// Expired entries with an ETag or Last-Modified header are revalidated with the app.
+type CachingRoundTripper struct {
+	next    http.RoundTripper // The next Transport to call on a cache miss.
+	config  *CacheConfig
+	mu      sync.Mutex
+	entries map[string]*cacheEntry
+	stats   CacheStats
+}
+
+type cacheEntry struct {
+	path     string // lower case request path, used for invalidation.
+	expires  time.Time
+	status   string
+	code     int
+	header   http.Header
+	body     []byte
+	etag     string
+	modified string
+}
+
+// NewCachingRoundTripper returns a round tripper that caches GET responses.
+// Put it into an http.Client Transport, and give that client to a starr.Config.
+func NewCachingRoundTripper(config CacheConfig, next http.RoundTripper) *CachingRoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	return &CachingRoundTripper{
+		next:    next,
+		config:  &config,
+		entries: make(map[string]*cacheEntry),
+	}
+}
+
+// ClientWithCache returns an http client with a response cache enabled.
+func ClientWithCache(timeout time.Duration, verifySSL bool, cacheConfig CacheConfig) *http.Client {
+	client := Client(timeout, verifySSL)
+	client.Transport = NewCachingRoundTripper(cacheConfig, client.Transport)
+
+	return client
+}
+
+// Stats returns the cache hit and miss counters.
+func (rt *CachingRoundTripper) Stats() CacheStats {
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+
+	return rt.stats
+}
+
+// Purge removes every entry from the cache.
+func (rt *CachingRoundTripper) Purge() {
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+
+	rt.entries = make(map[string]*cacheEntry)
+}
+
+// Unwrap returns the round tripper this cache wraps.
+func (rt *CachingRoundTripper) Unwrap() http.RoundTripper {
+	return rt.next
+}
+
+// RoundTrip satisfies the http.RoundTripper interface.
+func (rt *CachingRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet {
+		resp, err := rt.next.RoundTrip(req)
+		if err == nil && resp.StatusCode >= 200 && resp.StatusCode <= 299 {
+			rt.invalidate(req.URL.Path)
+		}
+
+		return resp, err //nolint:wrapcheck
+	}
+
+	ttl := rt.ttl(req.URL.Path)
+	if ttl <= 0 {
+		return rt.next.RoundTrip(req) //nolint:wrapcheck
+	}
+
+	key := cacheKey(req)
+	entry, fresh := rt.lookup(key)
+
+	if fresh {
+		rt.count(&rt.stats.Hits)
+		return entry.response(req), nil
+	}
+
+	if entry != nil { // expired, but may be revalidated.
+		req = req.Clone(req.Context())
+		if entry.etag != "" {
+			req.Header.Set("If-None-Match", entry.etag)
+		}
+
+		if entry.modified != "" {
+			req.Header.Set("If-Modified-Since", entry.modified)
+		}
+	}
+
+	resp, err := rt.next.RoundTrip(req)
+	if err != nil {
+		return resp, err //nolint:wrapcheck
+	}
+
+	if entry != nil && resp.StatusCode == http.StatusNotModified {
+		closeResp(resp)
+		rt.mu.Lock()
+		entry.expires = time.Now().Add(ttl)
+		rt.stats.Revalidated++
+		rt.mu.Unlock()
+
+		return entry.response(req), nil
+	}
+
+	rt.count(&rt.stats.Misses)
+
+	if resp.StatusCode != http.StatusOK {
+		return resp, nil
+	}
+
+	return rt.store(key, ttl, req, resp)
+}
+
+// store saves a response body in the cache and returns a response with a re-readable body.
+func (rt *CachingRoundTripper) store(
+	key string,
+	ttl time.Duration,
+	req *http.Request,
+	resp *http.Response,
+) (*http.Response, error) {
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	entry := &cacheEntry{
+		path:     strings.ToLower(req.URL.Path),
+		expires:  time.Now().Add(ttl),
+		status:   resp.Status,
+		code:     resp.StatusCode,
+		header:   resp.Header.Clone(),
+		body:     body,
+		etag:     resp.Header.Get("ETag"),
+		modified: resp.Header.Get("Last-Modified"),
+	}
+
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+
+	// Expired entries that cannot be revalidated are useless; drop them now.
+	for k, e := range rt.entries {
+		if e.etag == "" && e.modified == "" && time.Now().After(e.expires) {
+			delete(rt.entries, k)
+		}
+	}
+
+	rt.entries[key] = entry
+
+	return entry.response(req), nil
+}
+
+// lookup returns a cache entry, or nil if there isn't one, and whether it has not expired.
+// The expiry is checked here because a revalidation may update it concurrently.
+func (rt *CachingRoundTripper) lookup(key string) (*cacheEntry, bool) {
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+
+	entry := rt.entries[key]
+
+	return entry, entry != nil && time.Now().Before(entry.expires)
+}
+
+func (rt *CachingRoundTripper) count(counter *int64) {
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+
+	*counter++
+}
+
+// ttl returns the time to live for a request path using the longest matching prefix.
+func (rt *CachingRoundTripper) ttl(uriPath string) time.Duration {
+	ttl, match := rt.config.TTL, ""
+
+	for prefix, dur := range rt.config.TTLs {
+		if len(prefix) > len(match) && strings.HasPrefix(strings.ToLower(uriPath), strings.ToLower(prefix)) {
+			ttl, match = dur, prefix
+		}
+	}
+
+	return ttl
+}
+
+// invalidate removes every cache entry that belongs to the same resource as the provided path.
+// The resource is the first path element after the API version, so a PUT to /api/v3/movie/5
+// invalidates /api/v3/movie, /api/v3/movie/5 and /api/v3/movie/lookup.
+// The api element may follow a URL base, like /radarr/api/v3/movie/5.
+func (rt *CachingRoundTripper) invalidate(uriPath string) {
+	const apiDepth = 3 // api, version, resource
+
+	resource := strings.ToLower(strings.TrimSuffix(uriPath, "/"))
+	parts := strings.Split(strings.TrimPrefix(resource, "/"), "/")
+
+	for idx, part := range parts {
+		if part == API {
+			if len(parts) > idx+apiDepth {
+				resource = "/" + strings.Join(parts[:idx+apiDepth], "/")
+			}
+
+			break
+		}
+	}
+
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+
+	for key, entry := range rt.entries {
+		if entry.path == resource || strings.HasPrefix(entry.path, resource+"/") {
+			delete(rt.entries, key)
+			rt.stats.Invalidations++
+		}
+	}
+}
+
+// cacheKey includes the credentials so two Configs sharing a transport never share responses.
+func cacheKey(req *http.Request) string {
+	return req.URL.String() + "\n" + req.Header.Get("X-API-Key") + "\n" + req.Header.Get("Authorization")
+}
+
+// response creates a new http response from a cache entry.
+func (e *cacheEntry) response(req *http.Request) *http.Response {
+	return &http.Response{
+		Status:        e.status,
+		StatusCode:    e.code,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        e.header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(e.body)),
+		ContentLength: int64(len(e.body)),
+		Request:       req,
+	}
+}
@@ -0,0 +1,155 @@
+package starr_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/craigjmidwinter/starr"
+)
+
+func TestCachingRoundTripper(t *testing.T) {
+	t.Parallel()
+
+	var calls int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(fmt.Sprintf(`{"call": %d}`, atomic.AddInt32(&calls, 1))))
+	}))
+	defer server.Close()
+
+	cache := starr.NewCachingRoundTripper(starr.CacheConfig{
+		TTL:  time.Hour,
+		TTLs: map[string]time.Duration{"/api/v3/system": 0},
+	}, nil)
+	config := starr.New("mockAPIkey", server.URL, 0)
+	config.Client.Transport = cache
+
+	get := func(uri string) int {
+		var output struct{ Call int }
+
+		assert.NoError(t, config.GetInto(context.Background(), starr.Request{URI: uri}, &output))
+
+		return output.Call
+	}
+
+	assert.Equal(t, 1, get("/v3/movie"))
+	assert.Equal(t, 1, get("/v3/movie"), "the second request must come from the cache")
+	assert.Equal(t, 2, get("/v3/movie/5"))
+	assert.Equal(t, 3, get("/v3/system/status"), "a zero TTL must not be cached")
+	assert.Equal(t, 4, get("/v3/system/status"), "a zero TTL must not be cached")
+
+	assert.NoError(t, config.PutInto(context.Background(), starr.Request{URI: "/v3/movie/5"}, &struct{}{}))
+	assert.Equal(t, 6, get("/v3/movie"), "a PUT must invalidate the resource")
+	assert.Equal(t, 7, get("/v3/movie/5"), "a PUT must invalidate the resource")
+	assert.Equal(t, 7, get("/v3/movie/5"))
+
+	assert.Equal(t, starr.CacheStats{Hits: 2, Misses: 4, Invalidations: 2}, cache.Stats())
+}
+
+func TestCachingRoundTripperRevalidate(t *testing.T) {
+	t.Parallel()
+
+	var calls int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+
+		w.Header().Set("ETag", `"v1"`)
+		_, _ = w.Write([]byte(`{"call": 1}`))
+	}))
+	defer server.Close()
+
+	cache := starr.NewCachingRoundTripper(starr.CacheConfig{TTL: time.Nanosecond}, nil)
+	config := starr.New("mockAPIkey", server.URL, 0)
+	config.Client.Transport = cache
+
+	for i := 0; i < 3; i++ {
+		var output struct{ Call int }
+
+		assert.NoError(t, config.GetInto(context.Background(), starr.Request{URI: "/v3/series"}, &output))
+		assert.Equal(t, 1, output.Call, "the cached body must be returned after a 304")
+	}
+
+	assert.EqualValues(t, 3, atomic.LoadInt32(&calls))
+	assert.Equal(t, starr.CacheStats{Misses: 1, Revalidated: 2}, cache.Stats())
+}
+
+func TestCachingRoundTripperURLBase(t *testing.T) {
+	t.Parallel()
+
+	var calls int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(fmt.Sprintf(`{"call": %d}`, atomic.AddInt32(&calls, 1))))
+	}))
+	defer server.Close()
+
+	cache := starr.NewCachingRoundTripper(starr.CacheConfig{TTL: time.Hour}, nil)
+	config := starr.New("mockAPIkey", server.URL+"/sonarr", 0)
+	config.Client.Transport = cache
+
+	get := func(uri string) int {
+		var output struct{ Call int }
+
+		assert.NoError(t, config.GetInto(context.Background(), starr.Request{URI: uri}, &output))
+
+		return output.Call
+	}
+
+	assert.Equal(t, 1, get("/v3/series"))
+	assert.Equal(t, 1, get("/v3/series"), "the second request must come from the cache")
+	assert.NoError(t, config.PutInto(context.Background(), starr.Request{URI: "/v3/series/5"}, &struct{}{}))
+	assert.Equal(t, 3, get("/v3/series"), "a PUT below a URL base must invalidate the resource")
+	assert.Equal(t, starr.CacheStats{Hits: 1, Misses: 2, Invalidations: 1}, cache.Stats())
+}
+
+func TestCachingRoundTripperConcurrent(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+
+		w.Header().Set("ETag", `"v1"`)
+		_, _ = w.Write([]byte(`{"call": 1}`))
+	}))
+	defer server.Close()
+
+	// A tiny TTL makes most requests revalidate while others check the expiry.
+	cache := starr.NewCachingRoundTripper(starr.CacheConfig{TTL: time.Microsecond}, nil)
+	config := starr.New("mockAPIkey", server.URL, 0)
+	config.Client.Transport = cache
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for j := 0; j < 20; j++ {
+				var output struct{ Call int }
+
+				assert.NoError(t, config.GetInto(context.Background(), starr.Request{URI: "/v3/series"}, &output))
+				assert.Equal(t, 1, output.Call)
+			}
+		}()
+	}
+
+	wg.Wait()
+}
@@ -54,6 +54,11 @@ func NewLoggingRoundTripper(config Config, next http.RoundTripper) *LoggingRound
 	}
 }
 
+// Unwrap returns the round tripper this logger wraps.
+func (rt *LoggingRoundTripper) Unwrap() http.RoundTripper {
+	return rt.next
+}
+
 // RoundTrip satisfies the http.RoundTripper interface.
 func (rt *LoggingRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
 	buf := bytes.Buffer{}
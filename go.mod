@@ -2,7 +2,7 @@ module github.com/craigjmidwinter/starr
 
 go 1.17
 
-require golang.org/x/net v0.0.0-20221004154528-8021a29435af // publicsuffix, cookiejar.
+require golang.org/x/net v0.0.0-20221004154528-8021a29435af // publicsuffix, cookiejar, websocket.
 
 // All of this is for the tests.
 require (
@@ -48,6 +48,24 @@ func (c *Config) req(ctx context.Context, method string, req Request) (*http.Res
 		return nil, ErrNilClient
 	}
 
+	if c.Retry.retries(method) {
+		return c.Retry.do(ctx, method, req, c.send)
+	}
+
+	resp, err := c.send(ctx, method, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, parseNon200(resp)
+	}
+
+	return resp, nil
+}
+
+// send makes a single HTTP request. The response is returned regardless of status code.
+func (c *Config) send(ctx context.Context, method string, req Request) (*http.Response, error) {
 	httpReq, err := http.NewRequestWithContext(ctx, method, strings.TrimSuffix(c.URL, "/")+req.URI, req.Body)
 	if err != nil {
 		return nil, fmt.Errorf("http.NewRequestWithContext(%s): %w", req.URI, err)
@@ -59,52 +77,123 @@ func (c *Config) req(ctx context.Context, method string, req Request) (*http.Res
 		httpReq.URL.RawQuery = req.Query.Encode()
 	}
 
-	resp, err := c.Client.Do(httpReq)
+	release, err := c.Limit.wait(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("httpClient.Do(req): %w", err)
+		return nil, err
 	}
 
-	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		return nil, parseNon200(resp)
+	resp, err := c.Client.Do(httpReq)
+	if err != nil {
+		release()
+		return nil, &transportError{err: err}
 	}
 
+	limitBody(resp, release)
+
 	return resp, nil
 }
 
+// transportError wraps an error from the http client, like a refused connection or a timeout.
+// A Retry only retries these; errors building the request are permanent.
+type transportError struct {
+	err error
+}
+
+func (e *transportError) Error() string {
+	return "httpClient.Do(req): " + e.err.Error()
+}
+
+func (e *transportError) Unwrap() error {
+	return e.err
+}
+
+// ReqError is returned when a Starr app responds with a non-2xx status code.
+// Use errors.As() to access it. errors.Is(err, starr.ErrInvalidStatusCode) is true for
+// every ReqError, and errors.Is(err, starr.ErrInvalidAPIKey) is also true for a 401.
+type ReqError struct {
+	Code   int                // HTTP status code, like 404.
+	Status string             // HTTP status, like "404 Not Found".
+	Method string             // HTTP request method, like GET.
+	URI    string             // Request path and query.
+	Body   []byte             // Raw response body. May be empty.
+	Msg    string             // Parsed "message" from the response body, if present.
+	Errors []*ValidationError // Parsed validation errors from the response body, if present.
+}
+
+// ValidationError is returned in a list by the Starr apps when a request payload fails validation.
+// These are found in ReqError.Errors.
+type ValidationError struct {
+	PropertyName   string      `json:"propertyName"`
+	ErrorMessage   string      `json:"errorMessage"`
+	ErrorCode      string      `json:"errorCode,omitempty"`
+	Severity       string      `json:"severity,omitempty"`
+	AttemptedValue interface{} `json:"attemptedValue,omitempty"`
+	IsWarning      bool        `json:"isWarning,omitempty"`
+}
+
+// Error returns the request error as a string, including the most useful part of the response body.
+func (r *ReqError) Error() string {
+	const maxSize = 400 // arbitrary max size
+
+	msg := "failed, status: " + r.Status + ": " + ErrInvalidStatusCode.Error()
+
+	switch {
+	case r.Msg != "":
+		return msg + ": " + r.Msg
+	case len(r.Errors) > 0:
+		errs := make([]string, len(r.Errors))
+		for idx, verr := range r.Errors {
+			errs[idx] = verr.ErrorMessage + " (" + verr.PropertyName + ")"
+		}
+
+		return msg + ": " + strings.Join(errs, "; ")
+	case len(r.Body) > maxSize:
+		return msg + ": " + string(r.Body[:maxSize])
+	default:
+		return msg + ": " + string(r.Body)
+	}
+}
+
+// Is allows errors.Is() to match a ReqError with ErrInvalidStatusCode, and a 401 with ErrInvalidAPIKey.
+func (r *ReqError) Is(target error) bool {
+	return target == ErrInvalidStatusCode || //nolint:errorlint,goerr113
+		(target == ErrInvalidAPIKey && r.Code == http.StatusUnauthorized) //nolint:errorlint,goerr113
+}
+
 // parseNon200 attempts to extract an error message from a non-200 response.
 func parseNon200(resp *http.Response) error {
 	defer resp.Body.Close()
 
-	reply, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return fmt.Errorf("failed, status: %s: %w", resp.Status, ErrInvalidStatusCode)
+	reqErr := &ReqError{Code: resp.StatusCode, Status: resp.Status}
+	if resp.Request != nil {
+		reqErr.Method = resp.Request.Method
+		reqErr.URI = resp.Request.URL.RequestURI()
 	}
 
+	reqErr.Body, _ = io.ReadAll(resp.Body)
+
 	var msg struct {
 		Msg string `json:"message"`
 	}
 
-	if err := json.Unmarshal(reply, &msg); err == nil && msg.Msg != "" {
-		return fmt.Errorf("failed, status: %s: %w: %s", resp.Status, ErrInvalidStatusCode, msg.Msg)
-	}
-
-	var errMsg struct {
-		Msg  string `json:"errorMessage"`
-		Name string `json:"propertyName"`
+	if err := json.Unmarshal(reqErr.Body, &msg); err == nil && msg.Msg != "" {
+		reqErr.Msg = msg.Msg
+		return reqErr
 	}
 
-	if err := json.Unmarshal(reply, &errMsg); err == nil && errMsg.Msg != "" {
-		return fmt.Errorf("failed, status: %s: %w: %s (%s)", resp.Status, ErrInvalidStatusCode, errMsg.Msg, errMsg.Name)
+	// Validation errors usually come in a list, but handle a single one too.
+	var errs []*ValidationError
+	if err := json.Unmarshal(reqErr.Body, &errs); err == nil && len(errs) > 0 && errs[0].ErrorMessage != "" {
+		reqErr.Errors = errs
+		return reqErr
 	}
 
-	const maxSize = 400 // arbitrary max size
-
-	replyStr := string(reply)
-	if len(replyStr) > maxSize {
-		return fmt.Errorf("failed, status: %s: %w: %s", resp.Status, ErrInvalidStatusCode, replyStr[:maxSize])
+	var errMsg ValidationError
+	if err := json.Unmarshal(reqErr.Body, &errMsg); err == nil && errMsg.ErrorMessage != "" {
+		reqErr.Errors = []*ValidationError{&errMsg}
 	}
 
-	return fmt.Errorf("failed, status: %s: %w: %s", resp.Status, ErrInvalidStatusCode, replyStr)
+	return reqErr
 }
 
 // closeResp should be used to close requests that don't require a response body.
@@ -1,11 +1,18 @@
 package starr_test
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"path"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/craigjmidwinter/starr"
+	"github.com/craigjmidwinter/starr/debuglog"
 )
 
 func TestSetAPIPath(t *testing.T) {
@@ -33,3 +40,66 @@ func TestSetAPIPath(t *testing.T) {
 	assert.Equal(t, api+"/v1/test/another/level", starr.SetAPIPath("/api/v1/test/another/level"))
 	assert.Equal(t, api+"/v1/test/another/level", starr.SetAPIPath("/api/v1/test/another/level/"))
 }
+
+func TestReqError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		status int
+		body   string
+		msg    string
+		errs   int
+		apiKey bool
+	}{
+		{status: http.StatusNotFound, body: starr.BodyNotFound, msg: "NotFound"},
+		{status: http.StatusUnauthorized, body: starr.BodyUnauthorized, apiKey: true},
+		{status: http.StatusBadRequest, body: `[{"propertyName":"Path","errorMessage":"Invalid Path"},` +
+			`{"propertyName":"Name","errorMessage":"Must be unique"}]`, errs: 2},
+		{status: http.StatusBadRequest, body: `{"propertyName":"Path","errorMessage":"Invalid Path"}`, errs: 1},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(fmt.Sprint(test.status), func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(test.status)
+				_, _ = w.Write([]byte(test.body))
+			}))
+			defer server.Close()
+
+			config := starr.New("mockAPIkey", server.URL, 0)
+			err := config.GetInto(context.Background(), starr.Request{URI: "/v3/thing"}, &struct{}{})
+
+			var reqErr *starr.ReqError
+
+			require.ErrorAs(t, err, &reqErr)
+			assert.ErrorIs(t, err, starr.ErrInvalidStatusCode)
+			assert.Equal(t, test.apiKey, errors.Is(err, starr.ErrInvalidAPIKey))
+			assert.Equal(t, test.status, reqErr.Code)
+			assert.Equal(t, http.MethodGet, reqErr.Method)
+			assert.Equal(t, "/api/v3/thing", reqErr.URI)
+			assert.Equal(t, test.body, string(reqErr.Body))
+			assert.Equal(t, test.msg, reqErr.Msg)
+			assert.Len(t, reqErr.Errors, test.errs)
+		})
+	}
+}
+
+func TestClientWithDebugTLS(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	// verifySSL=false must still skip verification when the debug logger wraps the transport.
+	config := starr.New("mockAPIkey", server.URL, 0)
+	config.Client = starr.ClientWithDebug(0, false, debuglog.Config{Debugf: func(string, ...interface{}) {}})
+
+	var output struct{}
+
+	assert.NoError(t, config.GetInto(context.Background(), starr.Request{URI: "/v3/system/status"}, &output))
+}
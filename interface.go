@@ -27,6 +27,7 @@ type APIer interface {
 	GetInto(ctx context.Context, req Request, output interface{}) error  // API GET Request.
 	PostInto(ctx context.Context, req Request, output interface{}) error // API POST Request.
 	PutInto(ctx context.Context, req Request, output interface{}) error  // API PUT Request.
+	PostAny(ctx context.Context, req Request) error                      // API POST Request, output is ignored.
 	DeleteAny(ctx context.Context, req Request) error                    // API Delete request.
 }
 
@@ -105,6 +106,15 @@ func (c *Config) PutInto(ctx context.Context, req Request, output interface{}) e
 	return decode(output, resp, err)
 }
 
+// PostAny performs an HTTP POST against an API path, output is ignored.
+// Use this for endpoints that reply with an empty body, like the provider test endpoints.
+func (c *Config) PostAny(ctx context.Context, req Request) error {
+	resp, err := c.api(ctx, http.MethodPost, req)
+	closeResp(resp)
+
+	return err
+}
+
 // DeleteAny performs an HTTP DELETE against an API path, output is ignored.
 func (c *Config) DeleteAny(ctx context.Context, req Request) error {
 	resp, err := c.api(ctx, http.MethodDelete, req)
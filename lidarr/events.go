@@ -0,0 +1,106 @@
+package lidarr
+
+import (
+	"context"
+
+	"github.com/craigjmidwinter/starr"
+)
+
+// Event names Lidarr sends on its event stream. Compare these with starr.Event.Name.
+const (
+	EventQueue       = "queue"
+	EventQueueStatus = "queue/status"
+	EventCommand     = "command"
+	EventArtist      = "artist"
+	EventAlbum       = "album"
+	EventTrackFile   = "trackfile"
+	EventHealth      = "health"
+)
+
+// QueueStatus is the summary of the queue sent with a queue/status event.
+type QueueStatus struct {
+	TotalCount      int64 `json:"totalCount"`
+	Count           int64 `json:"count"`
+	UnknownCount    int64 `json:"unknownCount"`
+	Errors          bool  `json:"errors"`
+	Warnings        bool  `json:"warnings"`
+	UnknownErrors   bool  `json:"unknownErrors"`
+	UnknownWarnings bool  `json:"unknownWarnings"`
+}
+
+// QueueEvent is sent when the queue changes. Record is nil when the whole queue needs a refresh (action sync).
+type QueueEvent struct {
+	Action starr.EventAction
+	Record *QueueRecord
+}
+
+// QueueStatusEvent is sent when the queue counters change.
+type QueueStatusEvent struct {
+	Action starr.EventAction
+	Status *QueueStatus
+}
+
+// CommandEvent is sent when a command is queued, makes progress or finishes.
+type CommandEvent struct {
+	Action  starr.EventAction
+	Command *CommandResponse
+}
+
+// ArtistEvent is sent when an artist is added, updated or deleted.
+type ArtistEvent struct {
+	Action starr.EventAction
+	Artist *Artist
+}
+
+// AlbumEvent is sent when an album is added, updated or deleted.
+type AlbumEvent struct {
+	Action starr.EventAction
+	Album  *Album
+}
+
+// TrackFileEvent is sent when a track file is imported, changed or deleted.
+type TrackFileEvent struct {
+	Action    starr.EventAction
+	TrackFile *TrackFile
+}
+
+// HealthEvent is sent when the health checks change. Call GetSystemStatus or the health endpoint for details.
+type HealthEvent struct {
+	Action starr.EventAction
+}
+
+// ParseEvent turns a raw stream event into one of the typed events in this package.
+// Unknown events are returned as the provided *starr.Event.
+func ParseEvent(event *starr.Event) (interface{}, error) {
+	switch event.Name {
+	case EventQueue:
+		output := &QueueEvent{Action: event.Action}
+		return output, event.Resource(&output.Record)
+	case EventQueueStatus:
+		output := &QueueStatusEvent{Action: event.Action}
+		return output, event.Resource(&output.Status)
+	case EventCommand:
+		output := &CommandEvent{Action: event.Action}
+		return output, event.Resource(&output.Command)
+	case EventArtist:
+		output := &ArtistEvent{Action: event.Action}
+		return output, event.Resource(&output.Artist)
+	case EventAlbum:
+		output := &AlbumEvent{Action: event.Action}
+		return output, event.Resource(&output.Album)
+	case EventTrackFile:
+		output := &TrackFileEvent{Action: event.Action}
+		return output, event.Resource(&output.TrackFile)
+	case EventHealth:
+		return &HealthEvent{Action: event.Action}, nil
+	default:
+		return event, nil
+	}
+}
+
+// StreamEvents connects to Lidarr's event stream and returns a channel of typed events, like *QueueEvent.
+// Events that fail to parse are sent to config.OnError (if provided) and passed through as *starr.Event.
+// The channel is closed after the context is canceled. This requires a *starr.Config as the APIer.
+func (l *Lidarr) StreamEvents(ctx context.Context, config *starr.StreamConfig) (<-chan interface{}, error) {
+	return starr.StreamParsed(ctx, l.APIer, config, ParseEvent) //nolint:wrapcheck
+}
@@ -0,0 +1,83 @@
+package lidarr_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/craigjmidwinter/starr"
+	"github.com/craigjmidwinter/starr/lidarr"
+)
+
+func TestParseEvent(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		event    string
+		expected interface{}
+	}{
+		{
+			name:  "artist",
+			event: `{"name":"artist","body":{"action":"updated","resource":{"id":7,"artistName":"Band"}}}`,
+			expected: &lidarr.ArtistEvent{
+				Action: starr.EventActionUpdated,
+				Artist: &lidarr.Artist{ID: 7, ArtistName: "Band"},
+			},
+		},
+		{
+			name:  "album",
+			event: `{"name":"album","body":{"action":"updated","resource":{"id":4,"title":"Record","artistId":7}}}`,
+			expected: &lidarr.AlbumEvent{
+				Action: starr.EventActionUpdated,
+				Album:  &lidarr.Album{ID: 4, Title: "Record", ArtistID: 7},
+			},
+		},
+		{
+			name:  "track file",
+			event: `{"name":"trackfile","action":"deleted","body":{"resource":{"id":3,"artistId":7,"albumId":4}}}`,
+			expected: &lidarr.TrackFileEvent{
+				Action:    starr.EventActionDeleted,
+				TrackFile: &lidarr.TrackFile{ID: 3, ArtistID: 7, AlbumID: 4},
+			},
+		},
+		{
+			name:  "command",
+			event: `{"name":"command","body":{"action":"updated","resource":{"id":9,"name":"RefreshArtist"}}}`,
+			expected: &lidarr.CommandEvent{
+				Action:  starr.EventActionUpdated,
+				Command: &lidarr.CommandResponse{ID: 9, Name: "RefreshArtist"},
+			},
+		},
+		{
+			name:     "queue sync",
+			event:    `{"name":"queue","action":"sync","body":{"action":"sync"}}`,
+			expected: &lidarr.QueueEvent{Action: starr.EventActionSync},
+		},
+		{
+			name:     "health",
+			event:    `{"name":"health","action":"sync"}`,
+			expected: &lidarr.HealthEvent{Action: starr.EventActionSync},
+		},
+		{
+			name:     "unknown",
+			event:    `{"name":"calendar","action":"updated"}`,
+			expected: &starr.Event{Name: "calendar", Action: starr.EventActionUpdated},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			var event starr.Event
+			require.NoError(t, json.Unmarshal([]byte(test.event), &event))
+
+			output, err := lidarr.ParseEvent(&event)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, output)
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package starr
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"net/url"
 	"strconv"
@@ -11,6 +13,48 @@ import (
  * Like GetHistory() and GetQueue().
  */
 
+// ErrStopPaging may be returned by a PageFunc to stop Paginate() early without an error.
+var ErrStopPaging = fmt.Errorf("stop paging")
+
+// PageFunc retrieves and handles a single page of records using the provided request parameters.
+// It must return the number of records in the page, and the total number of records available.
+// Return ErrStopPaging to stop paging early.
+type PageFunc func(ctx context.Context, params *PageReq) (count int, total int, err error)
+
+// Paginate calls fetch once for every page of records until the total number of records, or the
+// number of records requested, has been retrieved. Passing zero for records retrieves all of them.
+// The params PageSize is the number of records requested per page. It does not change between pages,
+// because the apps find each page's offset with (page-1)*pageSize. This means the last page may
+// return more records than requested; the caller should trim the surplus after Paginate returns.
+// Any other params (like SortKey or Filter) are passed through to every page. params may be nil.
+// params is not modified; every page is fetched with a copy that has Page and PageSize set.
+// This allows walking very large lists, like history, a page at a time without holding it all in memory.
+// The paginated methods in the starr modules, like GetHistory() and GetQueue(), are built on this.
+func Paginate(ctx context.Context, params *PageReq, records int, fetch PageFunc) error {
+	var page PageReq
+	if params != nil {
+		page = *params
+	}
+
+	page.PageSize = SetPerPage(records, page.PageSize)
+	collected := 0
+
+	for page.Page = 1; ; page.Page++ {
+		count, total, err := fetch(ctx, &page)
+		if errors.Is(err, ErrStopPaging) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		collected += count
+
+		if collected >= total || (collected >= records && records != 0) || count == 0 {
+			return nil
+		}
+	}
+}
+
 // PageReq is the input to search requests that have page-able responses.
 // These are turned into HTTP parameters.
 type PageReq struct {
@@ -85,10 +129,9 @@ func (r *PageReq) Params() url.Values {
 		params.Set("sortDirection", "ascending") // descending
 	}
 
+	// Every value is kept for keys that have more than one, like movieIds. These replace the values above.
 	for k, v := range r.Values {
-		for _, val := range v {
-			params.Set(k, val)
-		}
+		params[k] = append([]string(nil), v...)
 	}
 
 	return params
@@ -170,6 +213,8 @@ func SetPerPage(records, perPage int) int {
 }
 
 // AdjustPerPage to make sure we don't go over, or ask for more records than exist.
+// Do not use this between pages of the same request: the apps find a page's offset
+// with (page-1)*pageSize, so shrinking the page size re-reads earlier records.
 // This is used by paginated methods in the starr modules.
 // 'records' is the number requested, 'total' is the number in the app,
 // 'collected' is how many we have so far, and 'perPage' is the current perPage setting.
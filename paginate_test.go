@@ -0,0 +1,151 @@
+package starr_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/craigjmidwinter/starr"
+	"github.com/craigjmidwinter/starr/sonarr"
+)
+
+// fakePages returns a PageFunc that pretends to have `total` records, and records the offset
+// and size of each page requested. The apps find a page's offset with (page-1)*pageSize.
+func fakePages(total int, offsets, sizes *[]int) starr.PageFunc {
+	return func(ctx context.Context, params *starr.PageReq) (int, int, error) {
+		offset := (params.Page - 1) * params.PageSize
+		*offsets = append(*offsets, offset)
+		*sizes = append(*sizes, params.PageSize)
+
+		count := total - offset
+		if count > params.PageSize {
+			count = params.PageSize
+		} else if count < 0 {
+			count = 0
+		}
+
+		return count, total, nil
+	}
+}
+
+func TestPaginate(t *testing.T) {
+	t.Parallel()
+
+	var offsets, sizes []int
+
+	assert.NoError(t, starr.Paginate(context.Background(),
+		&starr.PageReq{PageSize: 100}, 0, fakePages(250, &offsets, &sizes)))
+	assert.Equal(t, []int{0, 100, 200}, offsets, "all records should be retrieved without overlap")
+	assert.Equal(t, []int{100, 100, 100}, sizes, "the page size must not change between pages")
+
+	offsets, sizes = nil, nil
+	params := &starr.PageReq{PageSize: 100}
+	assert.NoError(t, starr.Paginate(context.Background(), params, 250, fakePages(1000, &offsets, &sizes)))
+	assert.Equal(t, &starr.PageReq{PageSize: 100}, params, "the caller's params must not be modified")
+	assert.Equal(t, []int{0, 100, 200}, offsets, "the last page is trimmed by the caller, not shrunk")
+	assert.Equal(t, []int{100, 100, 100}, sizes, "the page size must not change between pages")
+
+	offsets, sizes = nil, nil
+	assert.NoError(t, starr.Paginate(context.Background(), nil, 30, fakePages(250, &offsets, &sizes)))
+	assert.Equal(t, []int{30}, sizes, "only the records requested should be retrieved")
+
+	offsets, sizes = nil, nil
+	assert.NoError(t, starr.Paginate(context.Background(), nil, 0, fakePages(0, &offsets, &sizes)))
+	assert.Equal(t, []int{500}, sizes, "an empty list should stop after one page")
+}
+
+func TestPaginateRecords(t *testing.T) {
+	t.Parallel()
+
+	const total = 1000
+
+	// Serve records with IDs matching their position, using the apps' offset math.
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		size, _ := strconv.Atoi(r.URL.Query().Get("pageSize"))
+		records := []map[string]int{}
+
+		for id := (page - 1) * size; id < page*size && id < total; id++ {
+			records = append(records, map[string]int{"id": id})
+		}
+
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"totalRecords": total, "records": records})
+	}))
+	defer server.Close()
+
+	client := sonarr.New(starr.New("mockAPIkey", server.URL, 0))
+	history, err := client.GetHistory(250, 100)
+	require.NoError(t, err)
+	require.Len(t, history.Records, 250, "surplus records from the last page must be trimmed")
+
+	for idx, record := range history.Records {
+		assert.EqualValues(t, idx, record.ID, "records must not be duplicated or skipped")
+	}
+}
+
+func TestPaginateStop(t *testing.T) {
+	t.Parallel()
+
+	pages := 0
+	err := starr.Paginate(context.Background(), &starr.PageReq{PageSize: 10}, 0,
+		func(ctx context.Context, params *starr.PageReq) (int, int, error) {
+			if pages++; pages == 3 {
+				return 0, 0, starr.ErrStopPaging
+			}
+
+			return 10, 1000, nil
+		})
+	assert.NoError(t, err, "ErrStopPaging must not be returned")
+	assert.Equal(t, 3, pages)
+
+	err = starr.Paginate(context.Background(), nil, 0,
+		func(ctx context.Context, params *starr.PageReq) (int, int, error) {
+			return 0, 0, starr.ErrInvalidStatusCode
+		})
+	assert.ErrorIs(t, err, starr.ErrInvalidStatusCode)
+}
+
+// This example walks an entire Sonarr history one page at a time, without holding it all in memory.
+func ExamplePaginate() {
+	client := sonarr.New(starr.New("api-key", "http://localhost:8989", 0))
+	params := &starr.PageReq{PageSize: 1000, SortKey: "date", SortDir: starr.SortDescend}
+
+	err := starr.Paginate(context.Background(), params, 0,
+		func(ctx context.Context, params *starr.PageReq) (int, int, error) {
+			history, err := client.GetHistoryPageContext(ctx, params)
+			if err != nil {
+				return 0, 0, err
+			}
+
+			for _, record := range history.Records {
+				if record.EventType == "downloadFailed" {
+					fmt.Println("found a failed download, stopping:", record.SourceTitle)
+					return 0, 0, starr.ErrStopPaging
+				}
+			}
+
+			return len(history.Records), history.TotalRecords, nil
+		})
+	if err != nil {
+		panic(err)
+	}
+}
+
+func TestPageReqParams(t *testing.T) {
+	t.Parallel()
+
+	params := &starr.PageReq{
+		PageSize: 50,
+		Values:   map[string][]string{"movieIds": {"1", "2"}, "sortKey": {"title"}},
+	}
+
+	assert.Equal(t, []string{"1", "2"}, params.Params()["movieIds"], "every value must be kept")
+	assert.Equal(t, "title", params.Params().Get("sortKey"), "values must replace the defaults")
+	assert.Equal(t, "movieIds=1&movieIds=2&page=1&pageSize=50&sortDirection=ascending&sortKey=title", params.Encode())
+}
@@ -0,0 +1,196 @@
+package prowlarr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+
+	"github.com/craigjmidwinter/starr"
+)
+
+const bpApplications = APIver + "/applications"
+
+// SyncLevel is an enum used for applications. It controls how indexers get pushed to an application.
+type SyncLevel string
+
+// SyncLevel enum constants. Use these as inputs for "SyncLevel" member values.
+const (
+	SyncLevelDisabled SyncLevel = "disabled"
+	SyncLevelAddOnly  SyncLevel = "addOnly"
+	SyncLevelFullSync SyncLevel = "fullSync"
+)
+
+// ApplicationInput is the input for a new or updated application (sync target).
+type ApplicationInput struct {
+	SyncLevel      SyncLevel           `json:"syncLevel"`
+	ID             int64               `json:"id,omitempty"`
+	ConfigContract string              `json:"configContract"`
+	Implementation string              `json:"implementation"`
+	Name           string              `json:"name"`
+	Tags           []int               `json:"tags"`
+	Fields         []*starr.FieldInput `json:"fields"`
+}
+
+// ApplicationOutput is the output from the application methods.
+type ApplicationOutput struct {
+	SyncLevel          SyncLevel            `json:"syncLevel"`
+	ID                 int64                `json:"id,omitempty"`
+	ConfigContract     string               `json:"configContract"`
+	Implementation     string               `json:"implementation"`
+	ImplementationName string               `json:"implementationName"`
+	InfoLink           string               `json:"infoLink"`
+	Name               string               `json:"name"`
+	Tags               []int                `json:"tags"`
+	Fields             []*starr.FieldOutput `json:"fields"`
+}
+
+// GetApplications returns all configured applications.
+func (p *Prowlarr) GetApplications() ([]*ApplicationOutput, error) {
+	return p.GetApplicationsContext(context.Background())
+}
+
+// GetApplicationsContext returns all configured applications.
+func (p *Prowlarr) GetApplicationsContext(ctx context.Context) ([]*ApplicationOutput, error) {
+	var output []*ApplicationOutput
+
+	req := starr.Request{URI: bpApplications}
+	if err := p.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// GetApplication returns a single application.
+func (p *Prowlarr) GetApplication(appID int64) (*ApplicationOutput, error) {
+	return p.GetApplicationContext(context.Background(), appID)
+}
+
+// GetApplicationContext returns a single application.
+func (p *Prowlarr) GetApplicationContext(ctx context.Context, appID int64) (*ApplicationOutput, error) {
+	var output ApplicationOutput
+
+	req := starr.Request{URI: path.Join(bpApplications, fmt.Sprint(appID))}
+	if err := p.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// GetApplicationSchema returns the templates for every application type Prowlarr can sync to.
+// Use one of these as the starting point for an ApplicationInput passed into AddApplication().
+func (p *Prowlarr) GetApplicationSchema() ([]*ApplicationOutput, error) {
+	return p.GetApplicationSchemaContext(context.Background())
+}
+
+// GetApplicationSchemaContext returns the templates for every application type Prowlarr can sync to.
+func (p *Prowlarr) GetApplicationSchemaContext(ctx context.Context) ([]*ApplicationOutput, error) {
+	var output []*ApplicationOutput
+
+	req := starr.Request{URI: path.Join(bpApplications, "schema")}
+	if err := p.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// AddApplication creates an application.
+func (p *Prowlarr) AddApplication(application *ApplicationInput) (*ApplicationOutput, error) {
+	return p.AddApplicationContext(context.Background(), application)
+}
+
+// AddApplicationContext creates an application.
+func (p *Prowlarr) AddApplicationContext(
+	ctx context.Context,
+	application *ApplicationInput,
+) (*ApplicationOutput, error) {
+	var output ApplicationOutput
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(application); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpApplications, err)
+	}
+
+	req := starr.Request{URI: bpApplications, Body: &body}
+	if err := p.PostInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// TestApplication asks Prowlarr to validate an application's settings without saving it.
+// A nil error means Prowlarr was able to connect to the application.
+func (p *Prowlarr) TestApplication(application *ApplicationInput) error {
+	return p.TestApplicationContext(context.Background(), application)
+}
+
+// TestApplicationContext asks Prowlarr to validate an application's settings without saving it.
+func (p *Prowlarr) TestApplicationContext(ctx context.Context, application *ApplicationInput) error {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(application); err != nil {
+		return fmt.Errorf("json.Marshal(%s): %w", bpApplications, err)
+	}
+
+	req := starr.Request{URI: path.Join(bpApplications, "test"), Body: &body}
+	if err := p.PostAny(ctx, req); err != nil {
+		return fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+
+	return nil
+}
+
+// UpdateApplication updates an application.
+func (p *Prowlarr) UpdateApplication(application *ApplicationInput) (*ApplicationOutput, error) {
+	return p.UpdateApplicationContext(context.Background(), application)
+}
+
+// UpdateApplicationContext updates an application.
+func (p *Prowlarr) UpdateApplicationContext(
+	ctx context.Context,
+	application *ApplicationInput,
+) (*ApplicationOutput, error) {
+	var output ApplicationOutput
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(application); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpApplications, err)
+	}
+
+	req := starr.Request{URI: path.Join(bpApplications, fmt.Sprint(application.ID)), Body: &body}
+	if err := p.PutInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Put(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// DeleteApplication removes a single application.
+func (p *Prowlarr) DeleteApplication(appID int64) error {
+	return p.DeleteApplicationContext(context.Background(), appID)
+}
+
+// DeleteApplicationContext removes a single application.
+func (p *Prowlarr) DeleteApplicationContext(ctx context.Context, appID int64) error {
+	req := starr.Request{URI: path.Join(bpApplications, fmt.Sprint(appID))}
+	if err := p.DeleteAny(ctx, req); err != nil {
+		return fmt.Errorf("api.Delete(%s): %w", &req, err)
+	}
+
+	return nil
+}
+
+// SyncApplications triggers an ApplicationIndexerSync command, pushing indexers to every application.
+// Set force to true to overwrite indexer changes made inside the applications.
+func (p *Prowlarr) SyncApplications(force bool) (*CommandResponse, error) {
+	return p.SyncApplicationsContext(context.Background(), force)
+}
+
+// SyncApplicationsContext triggers an ApplicationIndexerSync command, pushing indexers to every application.
+func (p *Prowlarr) SyncApplicationsContext(ctx context.Context, force bool) (*CommandResponse, error) {
+	return p.SendCommandContext(ctx, &CommandRequest{Name: "ApplicationIndexerSync", ForceSync: force})
+}
@@ -0,0 +1,128 @@
+package prowlarr_test
+
+import (
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/craigjmidwinter/starr"
+	"github.com/craigjmidwinter/starr/prowlarr"
+)
+
+const applicationResponseBody = `{
+	"syncLevel": "fullSync",
+	"name": "Media Server",
+	"fields": [
+	  {
+		"order": 0,
+		"name": "prowlarrUrl",
+		"label": "Prowlarr Server",
+		"value": "http://localhost:9696",
+		"type": "textbox",
+		"advanced": false
+	  }
+	],
+	"implementationName": "Whisparr",
+	"implementation": "Whisparr",
+	"configContract": "WhisparrSettings",
+	"infoLink": "https://wiki.servarr.com/prowlarr/supported#whisparr",
+	"tags": [],
+	"id": 1
+  }`
+
+func TestGetApplications(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starr.TestMockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, prowlarr.APIver, "applications"),
+			ExpectedMethod: "GET",
+			ResponseStatus: 200,
+			ResponseBody:   "[" + applicationResponseBody + "]",
+			WithResponse: []*prowlarr.ApplicationOutput{
+				{
+					SyncLevel:          prowlarr.SyncLevelFullSync,
+					ID:                 1,
+					ConfigContract:     "WhisparrSettings",
+					Implementation:     "Whisparr",
+					ImplementationName: "Whisparr",
+					InfoLink:           "https://wiki.servarr.com/prowlarr/supported#whisparr",
+					Name:               "Media Server",
+					Tags:               []int{},
+					Fields: []*starr.FieldOutput{
+						{
+							Name:  "prowlarrUrl",
+							Label: "Prowlarr Server",
+							Value: "http://localhost:9696",
+							Type:  "textbox",
+						},
+					},
+				},
+			},
+			WithError: nil,
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, prowlarr.APIver, "applications"),
+			ExpectedMethod: "GET",
+			ResponseStatus: 404,
+			ResponseBody:   starr.BodyNotFound,
+			WithError:      starr.ErrInvalidStatusCode,
+			WithResponse:   ([]*prowlarr.ApplicationOutput)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := prowlarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.GetApplications()
+			assert.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
+
+func TestSyncApplications(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starr.TestMockData{
+		{
+			Name:            "201",
+			ExpectedPath:    path.Join("/", starr.API, prowlarr.APIver, "command"),
+			ExpectedMethod:  "POST",
+			ResponseStatus:  201,
+			WithRequest:     true,
+			ExpectedRequest: `{"name":"ApplicationIndexerSync","forceSync":true}` + "\n",
+			ResponseBody:    `{"id": 10, "name": "ApplicationIndexerSync", "status": "queued"}`,
+			WithResponse:    &prowlarr.CommandResponse{ID: 10, Name: "ApplicationIndexerSync", Status: "queued"},
+			WithError:       nil,
+		},
+		{
+			Name:            "404",
+			ExpectedPath:    path.Join("/", starr.API, prowlarr.APIver, "command"),
+			ExpectedMethod:  "POST",
+			ResponseStatus:  404,
+			WithRequest:     false,
+			ExpectedRequest: `{"name":"ApplicationIndexerSync"}` + "\n",
+			ResponseBody:    starr.BodyNotFound,
+			WithError:       starr.ErrInvalidStatusCode,
+			WithResponse:    (*prowlarr.CommandResponse)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := prowlarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.SyncApplications(test.WithRequest.(bool))
+			assert.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
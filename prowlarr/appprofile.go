@@ -0,0 +1,117 @@
+package prowlarr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+
+	"github.com/craigjmidwinter/starr"
+)
+
+const bpAppProfile = APIver + "/appprofile"
+
+// AppProfile is the /api/v1/appprofile endpoint.
+// App profiles control which search types an indexer is allowed to be synced with.
+type AppProfile struct {
+	ID                      int64  `json:"id,omitempty"`
+	Name                    string `json:"name"`
+	EnableRss               bool   `json:"enableRss"`
+	EnableAutomaticSearch   bool   `json:"enableAutomaticSearch"`
+	EnableInteractiveSearch bool   `json:"enableInteractiveSearch"`
+	MinimumSeeders          int64  `json:"minimumSeeders"`
+}
+
+// GetAppProfiles returns all configured app profiles.
+func (p *Prowlarr) GetAppProfiles() ([]*AppProfile, error) {
+	return p.GetAppProfilesContext(context.Background())
+}
+
+// GetAppProfilesContext returns all configured app profiles.
+func (p *Prowlarr) GetAppProfilesContext(ctx context.Context) ([]*AppProfile, error) {
+	var output []*AppProfile
+
+	req := starr.Request{URI: bpAppProfile}
+	if err := p.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// GetAppProfile returns a single app profile.
+func (p *Prowlarr) GetAppProfile(profileID int64) (*AppProfile, error) {
+	return p.GetAppProfileContext(context.Background(), profileID)
+}
+
+// GetAppProfileContext returns a single app profile.
+func (p *Prowlarr) GetAppProfileContext(ctx context.Context, profileID int64) (*AppProfile, error) {
+	var output AppProfile
+
+	req := starr.Request{URI: path.Join(bpAppProfile, fmt.Sprint(profileID))}
+	if err := p.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// AddAppProfile creates an app profile.
+func (p *Prowlarr) AddAppProfile(profile *AppProfile) (*AppProfile, error) {
+	return p.AddAppProfileContext(context.Background(), profile)
+}
+
+// AddAppProfileContext creates an app profile.
+func (p *Prowlarr) AddAppProfileContext(ctx context.Context, profile *AppProfile) (*AppProfile, error) {
+	var output AppProfile
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(profile); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpAppProfile, err)
+	}
+
+	req := starr.Request{URI: bpAppProfile, Body: &body}
+	if err := p.PostInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// UpdateAppProfile updates an app profile.
+func (p *Prowlarr) UpdateAppProfile(profile *AppProfile) (*AppProfile, error) {
+	return p.UpdateAppProfileContext(context.Background(), profile)
+}
+
+// UpdateAppProfileContext updates an app profile.
+func (p *Prowlarr) UpdateAppProfileContext(ctx context.Context, profile *AppProfile) (*AppProfile, error) {
+	var output AppProfile
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(profile); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpAppProfile, err)
+	}
+
+	req := starr.Request{URI: path.Join(bpAppProfile, fmt.Sprint(profile.ID)), Body: &body}
+	if err := p.PutInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Put(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// DeleteAppProfile removes a single app profile.
+func (p *Prowlarr) DeleteAppProfile(profileID int64) error {
+	return p.DeleteAppProfileContext(context.Background(), profileID)
+}
+
+// DeleteAppProfileContext removes a single app profile.
+func (p *Prowlarr) DeleteAppProfileContext(ctx context.Context, profileID int64) error {
+	req := starr.Request{URI: path.Join(bpAppProfile, fmt.Sprint(profileID))}
+	if err := p.DeleteAny(ctx, req); err != nil {
+		return fmt.Errorf("api.Delete(%s): %w", &req, err)
+	}
+
+	return nil
+}
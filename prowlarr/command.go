@@ -0,0 +1,107 @@
+package prowlarr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"time"
+
+	"github.com/craigjmidwinter/starr"
+)
+
+const bpCommand = APIver + "/command"
+
+// CommandRequest goes into the /api/v1/command endpoint.
+// Use Name "ApplicationIndexerSync" to push indexers to every configured application.
+// Set ForceSync to true with that command to overwrite changes made in the applications.
+type CommandRequest struct {
+	Name       string  `json:"name"`
+	IndexerIDs []int64 `json:"indexerIds,omitempty"`
+	ForceSync  bool    `json:"forceSync,omitempty"`
+}
+
+// CommandResponse comes from the /api/v1/command endpoint.
+type CommandResponse struct {
+	ID                  int64                  `json:"id"`
+	Name                string                 `json:"name"`
+	CommandName         string                 `json:"commandName"`
+	Message             string                 `json:"message,omitempty"`
+	Priority            string                 `json:"priority"`
+	Status              string                 `json:"status"`
+	Queued              time.Time              `json:"queued"`
+	Started             time.Time              `json:"started,omitempty"`
+	Ended               time.Time              `json:"ended,omitempty"`
+	StateChangeTime     time.Time              `json:"stateChangeTime,omitempty"`
+	LastExecutionTime   time.Time              `json:"lastExecutionTime,omitempty"`
+	Duration            string                 `json:"duration,omitempty"`
+	Trigger             string                 `json:"trigger"`
+	SendUpdatesToClient bool                   `json:"sendUpdatesToClient"`
+	UpdateScheduledTask bool                   `json:"updateScheduledTask"`
+	Body                map[string]interface{} `json:"body"`
+}
+
+// GetCommands returns all available Prowlarr commands.
+func (p *Prowlarr) GetCommands() ([]*CommandResponse, error) {
+	return p.GetCommandsContext(context.Background())
+}
+
+// GetCommandsContext returns all available Prowlarr commands.
+func (p *Prowlarr) GetCommandsContext(ctx context.Context) ([]*CommandResponse, error) {
+	var output []*CommandResponse
+
+	req := starr.Request{URI: bpCommand}
+	if err := p.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// SendCommand sends a command to Prowlarr.
+func (p *Prowlarr) SendCommand(cmd *CommandRequest) (*CommandResponse, error) {
+	return p.SendCommandContext(context.Background(), cmd)
+}
+
+// SendCommandContext sends a command to Prowlarr.
+func (p *Prowlarr) SendCommandContext(ctx context.Context, cmd *CommandRequest) (*CommandResponse, error) {
+	var output CommandResponse
+
+	if cmd == nil || cmd.Name == "" {
+		return &output, nil
+	}
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(cmd); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpCommand, err)
+	}
+
+	req := starr.Request{URI: bpCommand, Body: &body}
+	if err := p.PostInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// GetCommandStatus returns the status of an already started command.
+func (p *Prowlarr) GetCommandStatus(commandID int64) (*CommandResponse, error) {
+	return p.GetCommandStatusContext(context.Background(), commandID)
+}
+
+// GetCommandStatusContext returns the status of an already started command.
+func (p *Prowlarr) GetCommandStatusContext(ctx context.Context, commandID int64) (*CommandResponse, error) {
+	var output CommandResponse
+
+	if commandID == 0 {
+		return &output, nil
+	}
+
+	req := starr.Request{URI: path.Join(bpCommand, fmt.Sprint(commandID))}
+	if err := p.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
@@ -0,0 +1,56 @@
+package prowlarr
+
+import (
+	"context"
+
+	"github.com/craigjmidwinter/starr"
+)
+
+// Event names Prowlarr sends on its event stream. Compare these with starr.Event.Name.
+// Prowlarr has no download queue, so there are no queue events.
+const (
+	EventCommand = "command"
+	EventIndexer = "indexer"
+	EventHealth  = "health"
+)
+
+// CommandEvent is sent when a command is queued, makes progress or finishes.
+type CommandEvent struct {
+	Action  starr.EventAction
+	Command *CommandResponse
+}
+
+// IndexerEvent is sent when an indexer is added, updated or deleted.
+type IndexerEvent struct {
+	Action  starr.EventAction
+	Indexer *IndexerOutput
+}
+
+// HealthEvent is sent when the health checks change. Call the health endpoint for details.
+type HealthEvent struct {
+	Action starr.EventAction
+}
+
+// ParseEvent turns a raw stream event into one of the typed events in this package.
+// Unknown events are returned as the provided *starr.Event.
+func ParseEvent(event *starr.Event) (interface{}, error) {
+	switch event.Name {
+	case EventCommand:
+		output := &CommandEvent{Action: event.Action}
+		return output, event.Resource(&output.Command)
+	case EventIndexer:
+		output := &IndexerEvent{Action: event.Action}
+		return output, event.Resource(&output.Indexer)
+	case EventHealth:
+		return &HealthEvent{Action: event.Action}, nil
+	default:
+		return event, nil
+	}
+}
+
+// StreamEvents connects to Prowlarr's event stream and returns a channel of typed events, like *CommandEvent.
+// Events that fail to parse are sent to config.OnError (if provided) and passed through as *starr.Event.
+// The channel is closed after the context is canceled. This requires a *starr.Config as the APIer.
+func (p *Prowlarr) StreamEvents(ctx context.Context, config *starr.StreamConfig) (<-chan interface{}, error) {
+	return starr.StreamParsed(ctx, p.APIer, config, ParseEvent) //nolint:wrapcheck
+}
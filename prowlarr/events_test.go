@@ -0,0 +1,62 @@
+package prowlarr_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/craigjmidwinter/starr"
+	"github.com/craigjmidwinter/starr/prowlarr"
+)
+
+func TestParseEvent(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		event    string
+		expected interface{}
+	}{
+		{
+			name:  "indexer",
+			event: `{"name":"indexer","body":{"action":"updated","resource":{"id":7,"name":"Indexer","enable":true}}}`,
+			expected: &prowlarr.IndexerEvent{
+				Action:  starr.EventActionUpdated,
+				Indexer: &prowlarr.IndexerOutput{ID: 7, Name: "Indexer", Enable: true},
+			},
+		},
+		{
+			name:  "command",
+			event: `{"name":"command","body":{"action":"updated","resource":{"id":9,"name":"ApplicationIndexerSync"}}}`,
+			expected: &prowlarr.CommandEvent{
+				Action:  starr.EventActionUpdated,
+				Command: &prowlarr.CommandResponse{ID: 9, Name: "ApplicationIndexerSync"},
+			},
+		},
+		{
+			name:     "health",
+			event:    `{"name":"health","action":"sync"}`,
+			expected: &prowlarr.HealthEvent{Action: starr.EventActionSync},
+		},
+		{
+			name:     "unknown",
+			event:    `{"name":"calendar","action":"updated"}`,
+			expected: &starr.Event{Name: "calendar", Action: starr.EventActionUpdated},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			var event starr.Event
+			require.NoError(t, json.Unmarshal([]byte(test.event), &event))
+
+			output, err := prowlarr.ParseEvent(&event)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, output)
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package prowlarr
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/craigjmidwinter/starr"
+)
+
+const bpHistory = APIver + "/history"
+
+// History is the data from the /api/v1/history endpoint.
+type History struct {
+	Page          int              `json:"page"`
+	PageSize      int              `json:"pageSize"`
+	SortKey       string           `json:"sortKey"`
+	SortDirection string           `json:"sortDirection"`
+	TotalRecords  int              `json:"totalRecords"`
+	Records       []*HistoryRecord `json:"records"`
+}
+
+// HistoryRecord is part of the History data.
+// The Data map contents depend on EventType; queries include things like
+// "query", "queryType", "elapsedTime", "source" and "host".
+type HistoryRecord struct {
+	ID         int64             `json:"id"`
+	IndexerID  int64             `json:"indexerId"`
+	Date       time.Time         `json:"date"`
+	DownloadID string            `json:"downloadId,omitempty"`
+	Successful bool              `json:"successful"`
+	EventType  string            `json:"eventType"`
+	Data       map[string]string `json:"data"`
+}
+
+// GetHistory returns the Prowlarr History (grabs/queries/failures).
+// If you need control over the page, use prowlarr.GetHistoryPage().
+// This function simply returns the number of history records desired,
+// up to the number of records present in the application.
+// It grabs records in (paginated) batches of perPage, and concatenates
+// them into one list.  Passing zero for records will return all of them.
+func (p *Prowlarr) GetHistory(records, perPage int) (*History, error) {
+	return p.GetHistoryContext(context.Background(), records, perPage)
+}
+
+// GetHistoryContext returns the Prowlarr History (grabs/queries/failures).
+func (p *Prowlarr) GetHistoryContext(ctx context.Context, records, perPage int) (*History, error) {
+	hist := &History{Records: []*HistoryRecord{}}
+
+	err := starr.Paginate(ctx, &starr.PageReq{PageSize: perPage}, records,
+		func(ctx context.Context, params *starr.PageReq) (int, int, error) {
+			curr, err := p.GetHistoryPageContext(ctx, params)
+			if err != nil {
+				return 0, 0, err
+			}
+
+			hist.Records = append(hist.Records, curr.Records...)
+			hist.PageSize = curr.TotalRecords
+			hist.TotalRecords = curr.TotalRecords
+			hist.SortDirection = curr.SortDirection
+			hist.SortKey = curr.SortKey
+
+			return len(curr.Records), curr.TotalRecords, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	// The last page may return more records than requested.
+	if records > 0 && len(hist.Records) > records {
+		hist.Records = hist.Records[:records]
+	}
+
+	return hist, nil
+}
+
+// GetHistoryPage returns a single page from the Prowlarr History (grabs/queries/failures).
+// The page size and number is configurable with the input request parameters.
+// Set params.Filter to one of the prowlarr Filter constants to limit the event types returned.
+func (p *Prowlarr) GetHistoryPage(params *starr.PageReq) (*History, error) {
+	return p.GetHistoryPageContext(context.Background(), params)
+}
+
+// GetHistoryPageContext returns a single page from the Prowlarr History (grabs/queries/failures).
+// The page size and number is configurable with the input request parameters.
+func (p *Prowlarr) GetHistoryPageContext(ctx context.Context, params *starr.PageReq) (*History, error) {
+	var output History
+
+	req := starr.Request{URI: bpHistory, Query: params.Params()}
+	if err := p.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
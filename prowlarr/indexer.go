@@ -0,0 +1,223 @@
+package prowlarr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"time"
+
+	"github.com/craigjmidwinter/starr"
+)
+
+const bpIndexer = APIver + "/indexer"
+
+// IndexerInput is the input for a new or updated indexer.
+type IndexerInput struct {
+	Enable           bool                `json:"enable"`
+	Redirect         bool                `json:"redirect"`
+	Priority         int64               `json:"priority"`
+	AppProfileID     int64               `json:"appProfileId"`
+	DownloadClientID int64               `json:"downloadClientId,omitempty"`
+	ID               int64               `json:"id,omitempty"`
+	ConfigContract   string              `json:"configContract"`
+	Implementation   string              `json:"implementation"`
+	Name             string              `json:"name"`
+	Protocol         string              `json:"protocol"`
+	Privacy          string              `json:"privacy,omitempty"`
+	DefinitionName   string              `json:"definitionName,omitempty"`
+	Tags             []int               `json:"tags"`
+	Fields           []*starr.FieldInput `json:"fields"`
+}
+
+// IndexerOutput is the output from the indexer methods.
+type IndexerOutput struct {
+	Enable             bool                 `json:"enable"`
+	Redirect           bool                 `json:"redirect"`
+	SupportsRss        bool                 `json:"supportsRss"`
+	SupportsSearch     bool                 `json:"supportsSearch"`
+	SupportsRedirect   bool                 `json:"supportsRedirect"`
+	Priority           int64                `json:"priority"`
+	AppProfileID       int64                `json:"appProfileId"`
+	DownloadClientID   int64                `json:"downloadClientId,omitempty"`
+	ID                 int64                `json:"id,omitempty"`
+	Added              time.Time            `json:"added,omitempty"`
+	ConfigContract     string               `json:"configContract"`
+	Implementation     string               `json:"implementation"`
+	ImplementationName string               `json:"implementationName"`
+	InfoLink           string               `json:"infoLink"`
+	Name               string               `json:"name"`
+	SortName           string               `json:"sortName,omitempty"`
+	Description        string               `json:"description,omitempty"`
+	DefinitionName     string               `json:"definitionName,omitempty"`
+	Language           string               `json:"language,omitempty"`
+	Encoding           string               `json:"encoding,omitempty"`
+	Protocol           string               `json:"protocol"`
+	Privacy            string               `json:"privacy"`
+	IndexerURLs        []string             `json:"indexerUrls,omitempty"`
+	LegacyURLs         []string             `json:"legacyUrls,omitempty"`
+	Capabilities       *IndexerCapabilities `json:"capabilities,omitempty"`
+	Status             *IndexerStatus       `json:"status,omitempty"`
+	Tags               []int                `json:"tags"`
+	Fields             []*starr.FieldOutput `json:"fields"`
+}
+
+// IndexerCapabilities is part of IndexerOutput.
+type IndexerCapabilities struct {
+	ID                int64              `json:"id"`
+	LimitsMax         int64              `json:"limitsMax"`
+	LimitsDefault     int64              `json:"limitsDefault"`
+	SupportsRawSearch bool               `json:"supportsRawSearch"`
+	SearchParams      []string           `json:"searchParams"`
+	Categories        []*IndexerCategory `json:"categories"`
+}
+
+// IndexerCategory is part of IndexerCapabilities. Categories may be nested one level.
+type IndexerCategory struct {
+	ID            int64              `json:"id"`
+	Name          string             `json:"name"`
+	Description   string             `json:"description,omitempty"`
+	SubCategories []*IndexerCategory `json:"subCategories,omitempty"`
+}
+
+// IndexerStatus is part of IndexerOutput. Only present on indexers that are failing.
+type IndexerStatus struct {
+	ID                int64     `json:"id"`
+	IndexerID         int64     `json:"indexerId"`
+	DisabledTill      time.Time `json:"disabledTill,omitempty"`
+	MostRecentFailure time.Time `json:"mostRecentFailure,omitempty"`
+	InitialFailure    time.Time `json:"initialFailure,omitempty"`
+}
+
+// GetIndexers returns all configured indexers.
+func (p *Prowlarr) GetIndexers() ([]*IndexerOutput, error) {
+	return p.GetIndexersContext(context.Background())
+}
+
+// GetIndexersContext returns all configured indexers.
+func (p *Prowlarr) GetIndexersContext(ctx context.Context) ([]*IndexerOutput, error) {
+	var output []*IndexerOutput
+
+	req := starr.Request{URI: bpIndexer}
+	if err := p.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// GetIndexer returns a single indexer.
+func (p *Prowlarr) GetIndexer(indexerID int64) (*IndexerOutput, error) {
+	return p.GetIndexerContext(context.Background(), indexerID)
+}
+
+// GetIndexerContext returns a single indexer.
+func (p *Prowlarr) GetIndexerContext(ctx context.Context, indexerID int64) (*IndexerOutput, error) {
+	var output IndexerOutput
+
+	req := starr.Request{URI: path.Join(bpIndexer, fmt.Sprint(indexerID))}
+	if err := p.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// GetIndexerSchema returns the template for every indexer definition Prowlarr knows about.
+// Use one of these as the starting point for an IndexerInput passed into AddIndexer().
+func (p *Prowlarr) GetIndexerSchema() ([]*IndexerOutput, error) {
+	return p.GetIndexerSchemaContext(context.Background())
+}
+
+// GetIndexerSchemaContext returns the template for every indexer definition Prowlarr knows about.
+func (p *Prowlarr) GetIndexerSchemaContext(ctx context.Context) ([]*IndexerOutput, error) {
+	var output []*IndexerOutput
+
+	req := starr.Request{URI: path.Join(bpIndexer, "schema")}
+	if err := p.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// AddIndexer creates an indexer.
+func (p *Prowlarr) AddIndexer(indexer *IndexerInput) (*IndexerOutput, error) {
+	return p.AddIndexerContext(context.Background(), indexer)
+}
+
+// AddIndexerContext creates an indexer.
+func (p *Prowlarr) AddIndexerContext(ctx context.Context, indexer *IndexerInput) (*IndexerOutput, error) {
+	var output IndexerOutput
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(indexer); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpIndexer, err)
+	}
+
+	req := starr.Request{URI: bpIndexer, Body: &body}
+	if err := p.PostInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// TestIndexer asks Prowlarr to validate an indexer's settings without saving it.
+// A nil error means the test passed.
+func (p *Prowlarr) TestIndexer(indexer *IndexerInput) error {
+	return p.TestIndexerContext(context.Background(), indexer)
+}
+
+// TestIndexerContext asks Prowlarr to validate an indexer's settings without saving it.
+func (p *Prowlarr) TestIndexerContext(ctx context.Context, indexer *IndexerInput) error {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(indexer); err != nil {
+		return fmt.Errorf("json.Marshal(%s): %w", bpIndexer, err)
+	}
+
+	req := starr.Request{URI: path.Join(bpIndexer, "test"), Body: &body}
+	if err := p.PostAny(ctx, req); err != nil {
+		return fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+
+	return nil
+}
+
+// UpdateIndexer updates the indexer.
+func (p *Prowlarr) UpdateIndexer(indexer *IndexerInput) (*IndexerOutput, error) {
+	return p.UpdateIndexerContext(context.Background(), indexer)
+}
+
+// UpdateIndexerContext updates the indexer.
+func (p *Prowlarr) UpdateIndexerContext(ctx context.Context, indexer *IndexerInput) (*IndexerOutput, error) {
+	var output IndexerOutput
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(indexer); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpIndexer, err)
+	}
+
+	req := starr.Request{URI: path.Join(bpIndexer, fmt.Sprint(indexer.ID)), Body: &body}
+	if err := p.PutInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Put(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// DeleteIndexer removes a single indexer.
+func (p *Prowlarr) DeleteIndexer(indexerID int64) error {
+	return p.DeleteIndexerContext(context.Background(), indexerID)
+}
+
+// DeleteIndexerContext removes a single indexer.
+func (p *Prowlarr) DeleteIndexerContext(ctx context.Context, indexerID int64) error {
+	req := starr.Request{URI: path.Join(bpIndexer, fmt.Sprint(indexerID))}
+	if err := p.DeleteAny(ctx, req); err != nil {
+		return fmt.Errorf("api.Delete(%s): %w", &req, err)
+	}
+
+	return nil
+}
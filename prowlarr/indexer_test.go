@@ -0,0 +1,265 @@
+package prowlarr_test
+
+import (
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/craigjmidwinter/starr"
+	"github.com/craigjmidwinter/starr/prowlarr"
+)
+
+const indexerResponseBody = `{
+	"enable": true,
+	"redirect": false,
+	"supportsRss": true,
+	"supportsSearch": true,
+	"supportsRedirect": false,
+	"appProfileId": 1,
+	"protocol": "usenet",
+	"privacy": "private",
+	"priority": 25,
+	"name": "NZBgeek",
+	"fields": [
+	  {
+		"order": 0,
+		"name": "baseUrl",
+		"label": "URL",
+		"value": "https://api.nzbgeek.info",
+		"type": "textbox",
+		"advanced": false
+	  }
+	],
+	"implementationName": "Newznab",
+	"implementation": "Newznab",
+	"configContract": "NewznabSettings",
+	"infoLink": "https://wiki.servarr.com/prowlarr/supported-indexers#nzbgeek",
+	"tags": [],
+	"id": 1
+  }`
+
+const addIndexer = `{"enable":true,"redirect":false,"priority":25,"appProfileId":1,` +
+	`"configContract":"NewznabSettings","implementation":"Newznab","name":"NZBgeek","protocol":"usenet",` +
+	`"tags":[],"fields":[{"name":"baseUrl","value":"https://api.nzbgeek.info"}]}`
+
+func indexerInput() *prowlarr.IndexerInput {
+	return &prowlarr.IndexerInput{
+		Enable:         true,
+		Priority:       25,
+		AppProfileID:   1,
+		ConfigContract: "NewznabSettings",
+		Implementation: "Newznab",
+		Name:           "NZBgeek",
+		Protocol:       "usenet",
+		Tags:           []int{},
+		Fields:         []*starr.FieldInput{{Name: "baseUrl", Value: "https://api.nzbgeek.info"}},
+	}
+}
+
+func indexerOutput() *prowlarr.IndexerOutput {
+	return &prowlarr.IndexerOutput{
+		Enable:             true,
+		SupportsRss:        true,
+		SupportsSearch:     true,
+		AppProfileID:       1,
+		Priority:           25,
+		ID:                 1,
+		ConfigContract:     "NewznabSettings",
+		Implementation:     "Newznab",
+		ImplementationName: "Newznab",
+		InfoLink:           "https://wiki.servarr.com/prowlarr/supported-indexers#nzbgeek",
+		Name:               "NZBgeek",
+		Protocol:           "usenet",
+		Privacy:            "private",
+		Tags:               []int{},
+		Fields: []*starr.FieldOutput{
+			{
+				Order: 0,
+				Name:  "baseUrl",
+				Label: "URL",
+				Value: "https://api.nzbgeek.info",
+				Type:  "textbox",
+			},
+		},
+	}
+}
+
+func TestGetIndexers(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starr.TestMockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, prowlarr.APIver, "indexer"),
+			ExpectedMethod: "GET",
+			ResponseStatus: 200,
+			ResponseBody:   "[" + indexerResponseBody + "]",
+			WithResponse:   []*prowlarr.IndexerOutput{indexerOutput()},
+			WithError:      nil,
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, prowlarr.APIver, "indexer"),
+			ExpectedMethod: "GET",
+			ResponseStatus: 404,
+			ResponseBody:   starr.BodyNotFound,
+			WithError:      starr.ErrInvalidStatusCode,
+			WithResponse:   ([]*prowlarr.IndexerOutput)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := prowlarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.GetIndexers()
+			assert.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
+
+func TestGetIndexerSchema(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starr.TestMockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, prowlarr.APIver, "indexer", "schema"),
+			ExpectedMethod: "GET",
+			ResponseStatus: 200,
+			ResponseBody:   "[" + indexerResponseBody + "]",
+			WithResponse:   []*prowlarr.IndexerOutput{indexerOutput()},
+			WithError:      nil,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := prowlarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.GetIndexerSchema()
+			assert.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
+
+func TestAddIndexer(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starr.TestMockData{
+		{
+			Name:            "200",
+			ExpectedPath:    path.Join("/", starr.API, prowlarr.APIver, "indexer"),
+			ExpectedMethod:  "POST",
+			ResponseStatus:  200,
+			WithRequest:     indexerInput(),
+			ExpectedRequest: addIndexer + "\n",
+			ResponseBody:    indexerResponseBody,
+			WithResponse:    indexerOutput(),
+			WithError:       nil,
+		},
+		{
+			Name:            "404",
+			ExpectedPath:    path.Join("/", starr.API, prowlarr.APIver, "indexer"),
+			ExpectedMethod:  "POST",
+			ResponseStatus:  404,
+			WithRequest:     indexerInput(),
+			ExpectedRequest: addIndexer + "\n",
+			ResponseBody:    starr.BodyNotFound,
+			WithError:       starr.ErrInvalidStatusCode,
+			WithResponse:    (*prowlarr.IndexerOutput)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := prowlarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.AddIndexer(test.WithRequest.(*prowlarr.IndexerInput))
+			assert.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
+
+func TestTestIndexer(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starr.TestMockData{
+		{
+			Name:            "200",
+			ExpectedPath:    path.Join("/", starr.API, prowlarr.APIver, "indexer", "test"),
+			ExpectedMethod:  "POST",
+			ResponseStatus:  200,
+			WithRequest:     indexerInput(),
+			ExpectedRequest: addIndexer + "\n",
+			ResponseBody:    "",
+			WithError:       nil,
+		},
+		{
+			Name:            "400",
+			ExpectedPath:    path.Join("/", starr.API, prowlarr.APIver, "indexer", "test"),
+			ExpectedMethod:  "POST",
+			ResponseStatus:  400,
+			WithRequest:     indexerInput(),
+			ExpectedRequest: addIndexer + "\n",
+			ResponseBody:    `[{"propertyName": "ApiKey", "errorMessage": "Invalid API Key"}]`,
+			WithError:       starr.ErrInvalidStatusCode,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := prowlarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			err := client.TestIndexer(test.WithRequest.(*prowlarr.IndexerInput))
+			assert.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+		})
+	}
+}
+
+func TestDeleteIndexer(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starr.TestMockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, prowlarr.APIver, "indexer", "2"),
+			ExpectedMethod: "DELETE",
+			WithRequest:    int64(2),
+			ResponseStatus: 200,
+			ResponseBody:   "{}",
+			WithError:      nil,
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, prowlarr.APIver, "indexer", "2"),
+			ExpectedMethod: "DELETE",
+			WithRequest:    int64(2),
+			ResponseStatus: 404,
+			ResponseBody:   starr.BodyNotFound,
+			WithError:      starr.ErrInvalidStatusCode,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := prowlarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			err := client.DeleteIndexer(test.WithRequest.(int64))
+			assert.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+		})
+	}
+}
@@ -0,0 +1,176 @@
+package prowlarr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+
+	"github.com/craigjmidwinter/starr"
+)
+
+const bpIndexerProxy = APIver + "/indexerproxy"
+
+// IndexerProxyInput is the input for a new or updated indexer proxy.
+// Proxies are things like FlareSolverr, HTTP or SOCKS proxies; indexers use them by sharing a tag.
+type IndexerProxyInput struct {
+	OnHealthIssue         bool                `json:"onHealthIssue"`
+	IncludeHealthWarnings bool                `json:"includeHealthWarnings"`
+	ID                    int64               `json:"id,omitempty"`
+	ConfigContract        string              `json:"configContract"`
+	Implementation        string              `json:"implementation"`
+	Name                  string              `json:"name"`
+	Tags                  []int               `json:"tags"`
+	Fields                []*starr.FieldInput `json:"fields"`
+}
+
+// IndexerProxyOutput is the output from the indexer proxy methods.
+type IndexerProxyOutput struct {
+	OnHealthIssue         bool                 `json:"onHealthIssue"`
+	SupportsOnHealthIssue bool                 `json:"supportsOnHealthIssue"`
+	IncludeHealthWarnings bool                 `json:"includeHealthWarnings"`
+	ID                    int64                `json:"id,omitempty"`
+	ConfigContract        string               `json:"configContract"`
+	Implementation        string               `json:"implementation"`
+	ImplementationName    string               `json:"implementationName"`
+	InfoLink              string               `json:"infoLink"`
+	Name                  string               `json:"name"`
+	Link                  string               `json:"link,omitempty"`
+	Tags                  []int                `json:"tags"`
+	Fields                []*starr.FieldOutput `json:"fields"`
+}
+
+// GetIndexerProxies returns all configured indexer proxies.
+func (p *Prowlarr) GetIndexerProxies() ([]*IndexerProxyOutput, error) {
+	return p.GetIndexerProxiesContext(context.Background())
+}
+
+// GetIndexerProxiesContext returns all configured indexer proxies.
+func (p *Prowlarr) GetIndexerProxiesContext(ctx context.Context) ([]*IndexerProxyOutput, error) {
+	var output []*IndexerProxyOutput
+
+	req := starr.Request{URI: bpIndexerProxy}
+	if err := p.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// GetIndexerProxy returns a single indexer proxy.
+func (p *Prowlarr) GetIndexerProxy(proxyID int64) (*IndexerProxyOutput, error) {
+	return p.GetIndexerProxyContext(context.Background(), proxyID)
+}
+
+// GetIndexerProxyContext returns a single indexer proxy.
+func (p *Prowlarr) GetIndexerProxyContext(ctx context.Context, proxyID int64) (*IndexerProxyOutput, error) {
+	var output IndexerProxyOutput
+
+	req := starr.Request{URI: path.Join(bpIndexerProxy, fmt.Sprint(proxyID))}
+	if err := p.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// GetIndexerProxySchema returns the templates for every indexer proxy type.
+func (p *Prowlarr) GetIndexerProxySchema() ([]*IndexerProxyOutput, error) {
+	return p.GetIndexerProxySchemaContext(context.Background())
+}
+
+// GetIndexerProxySchemaContext returns the templates for every indexer proxy type.
+func (p *Prowlarr) GetIndexerProxySchemaContext(ctx context.Context) ([]*IndexerProxyOutput, error) {
+	var output []*IndexerProxyOutput
+
+	req := starr.Request{URI: path.Join(bpIndexerProxy, "schema")}
+	if err := p.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// AddIndexerProxy creates an indexer proxy.
+func (p *Prowlarr) AddIndexerProxy(proxy *IndexerProxyInput) (*IndexerProxyOutput, error) {
+	return p.AddIndexerProxyContext(context.Background(), proxy)
+}
+
+// AddIndexerProxyContext creates an indexer proxy.
+func (p *Prowlarr) AddIndexerProxyContext(ctx context.Context, proxy *IndexerProxyInput) (*IndexerProxyOutput, error) {
+	var output IndexerProxyOutput
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(proxy); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpIndexerProxy, err)
+	}
+
+	req := starr.Request{URI: bpIndexerProxy, Body: &body}
+	if err := p.PostInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// TestIndexerProxy asks Prowlarr to validate an indexer proxy's settings without saving it.
+// A nil error means the test passed.
+func (p *Prowlarr) TestIndexerProxy(proxy *IndexerProxyInput) error {
+	return p.TestIndexerProxyContext(context.Background(), proxy)
+}
+
+// TestIndexerProxyContext asks Prowlarr to validate an indexer proxy's settings without saving it.
+func (p *Prowlarr) TestIndexerProxyContext(ctx context.Context, proxy *IndexerProxyInput) error {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(proxy); err != nil {
+		return fmt.Errorf("json.Marshal(%s): %w", bpIndexerProxy, err)
+	}
+
+	req := starr.Request{URI: path.Join(bpIndexerProxy, "test"), Body: &body}
+	if err := p.PostAny(ctx, req); err != nil {
+		return fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+
+	return nil
+}
+
+// UpdateIndexerProxy updates an indexer proxy.
+func (p *Prowlarr) UpdateIndexerProxy(proxy *IndexerProxyInput) (*IndexerProxyOutput, error) {
+	return p.UpdateIndexerProxyContext(context.Background(), proxy)
+}
+
+// UpdateIndexerProxyContext updates an indexer proxy.
+func (p *Prowlarr) UpdateIndexerProxyContext(
+	ctx context.Context,
+	proxy *IndexerProxyInput,
+) (*IndexerProxyOutput, error) {
+	var output IndexerProxyOutput
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(proxy); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpIndexerProxy, err)
+	}
+
+	req := starr.Request{URI: path.Join(bpIndexerProxy, fmt.Sprint(proxy.ID)), Body: &body}
+	if err := p.PutInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Put(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// DeleteIndexerProxy removes a single indexer proxy.
+func (p *Prowlarr) DeleteIndexerProxy(proxyID int64) error {
+	return p.DeleteIndexerProxyContext(context.Background(), proxyID)
+}
+
+// DeleteIndexerProxyContext removes a single indexer proxy.
+func (p *Prowlarr) DeleteIndexerProxyContext(ctx context.Context, proxyID int64) error {
+	req := starr.Request{URI: path.Join(bpIndexerProxy, fmt.Sprint(proxyID))}
+	if err := p.DeleteAny(ctx, req); err != nil {
+		return fmt.Errorf("api.Delete(%s): %w", &req, err)
+	}
+
+	return nil
+}
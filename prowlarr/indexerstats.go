@@ -0,0 +1,104 @@
+package prowlarr
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/craigjmidwinter/starr"
+)
+
+const bpIndexerStats = APIver + "/indexerstats"
+
+// IndexerStats is the /api/v1/indexerstats endpoint.
+type IndexerStats struct {
+	ID         int64               `json:"id"`
+	Indexers   []*IndexerStatEntry `json:"indexers"`
+	UserAgents []*UserAgentStats   `json:"userAgents"`
+	Hosts      []*HostStats        `json:"hosts"`
+}
+
+// IndexerStatEntry is part of IndexerStats. AverageResponseTime is in milliseconds.
+type IndexerStatEntry struct {
+	IndexerID                 int64  `json:"indexerId"`
+	IndexerName               string `json:"indexerName"`
+	AverageResponseTime       int64  `json:"averageResponseTime"`
+	NumberOfQueries           int64  `json:"numberOfQueries"`
+	NumberOfGrabs             int64  `json:"numberOfGrabs"`
+	NumberOfRssQueries        int64  `json:"numberOfRssQueries"`
+	NumberOfAuthQueries       int64  `json:"numberOfAuthQueries"`
+	NumberOfFailedQueries     int64  `json:"numberOfFailedQueries"`
+	NumberOfFailedGrabs       int64  `json:"numberOfFailedGrabs"`
+	NumberOfFailedRssQueries  int64  `json:"numberOfFailedRssQueries"`
+	NumberOfFailedAuthQueries int64  `json:"numberOfFailedAuthQueries"`
+}
+
+// UserAgentStats is part of IndexerStats.
+type UserAgentStats struct {
+	UserAgent       string `json:"userAgent"`
+	NumberOfQueries int64  `json:"numberOfQueries"`
+	NumberOfGrabs   int64  `json:"numberOfGrabs"`
+}
+
+// HostStats is part of IndexerStats.
+type HostStats struct {
+	Host            string `json:"host"`
+	NumberOfQueries int64  `json:"numberOfQueries"`
+	NumberOfGrabs   int64  `json:"numberOfGrabs"`
+}
+
+// IndexerStatsFilter limits the indexer statistics returned. All members are optional.
+type IndexerStatsFilter struct {
+	Start      time.Time
+	End        time.Time
+	IndexerIDs []int64
+	Tags       []int
+}
+
+// GetIndexerStats returns indexer, user agent and host statistics.
+// Pass an empty filter to get statistics for all time.
+func (p *Prowlarr) GetIndexerStats(filter IndexerStatsFilter) (*IndexerStats, error) {
+	return p.GetIndexerStatsContext(context.Background(), filter)
+}
+
+// GetIndexerStatsContext returns indexer, user agent and host statistics.
+func (p *Prowlarr) GetIndexerStatsContext(ctx context.Context, filter IndexerStatsFilter) (*IndexerStats, error) {
+	var output IndexerStats
+
+	req := starr.Request{URI: bpIndexerStats, Query: make(url.Values)}
+
+	if !filter.Start.IsZero() {
+		req.Query.Set("startDate", filter.Start.UTC().Format(starr.CalendarTimeFilterFormat))
+	}
+
+	if !filter.End.IsZero() {
+		req.Query.Set("endDate", filter.End.UTC().Format(starr.CalendarTimeFilterFormat))
+	}
+
+	// Prowlarr wants these as comma separated lists.
+	if len(filter.IndexerIDs) > 0 {
+		ids := make([]string, len(filter.IndexerIDs))
+		for idx, id := range filter.IndexerIDs {
+			ids[idx] = fmt.Sprint(id)
+		}
+
+		req.Query.Set("indexers", strings.Join(ids, ","))
+	}
+
+	if len(filter.Tags) > 0 {
+		tags := make([]string, len(filter.Tags))
+		for idx, tag := range filter.Tags {
+			tags[idx] = fmt.Sprint(tag)
+		}
+
+		req.Query.Set("tags", strings.Join(tags, ","))
+	}
+
+	if err := p.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
@@ -0,0 +1,81 @@
+package prowlarr_test
+
+import (
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/craigjmidwinter/starr"
+	"github.com/craigjmidwinter/starr/prowlarr"
+)
+
+const indexerStatsResponseBody = `{
+	"id": 1,
+	"indexers": [{
+		"indexerId": 3,
+		"indexerName": "Example",
+		"averageResponseTime": 512,
+		"numberOfQueries": 100,
+		"numberOfGrabs": 10,
+		"numberOfFailedQueries": 7
+	}],
+	"userAgents": [{"userAgent": "Agent/1.0", "numberOfQueries": 100, "numberOfGrabs": 10}],
+	"hosts": [{"host": "localhost", "numberOfQueries": 100, "numberOfGrabs": 10}]
+  }`
+
+func TestGetIndexerStats(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starr.TestMockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, prowlarr.APIver, "indexerstats"),
+			ExpectedMethod: "GET",
+			ResponseStatus: 200,
+			WithRequest:    prowlarr.IndexerStatsFilter{},
+			ResponseBody:   indexerStatsResponseBody,
+			WithResponse: &prowlarr.IndexerStats{
+				ID: 1,
+				Indexers: []*prowlarr.IndexerStatEntry{{
+					IndexerID:             3,
+					IndexerName:           "Example",
+					AverageResponseTime:   512,
+					NumberOfQueries:       100,
+					NumberOfGrabs:         10,
+					NumberOfFailedQueries: 7,
+				}},
+				UserAgents: []*prowlarr.UserAgentStats{{UserAgent: "Agent/1.0", NumberOfQueries: 100, NumberOfGrabs: 10}},
+				Hosts:      []*prowlarr.HostStats{{Host: "localhost", NumberOfQueries: 100, NumberOfGrabs: 10}},
+			},
+			WithError: nil,
+		},
+		{
+			Name: "404",
+			ExpectedPath: path.Join("/", starr.API, prowlarr.APIver, "indexerstats") +
+				"?endDate=2022-10-02T12%3A00%3A00.000Z&indexers=3%2C4&startDate=2022-10-01T12%3A00%3A00.000Z",
+			ExpectedMethod: "GET",
+			ResponseStatus: 404,
+			WithRequest: prowlarr.IndexerStatsFilter{
+				Start:      time.Date(2022, 10, 1, 12, 0, 0, 0, time.UTC),
+				End:        time.Date(2022, 10, 2, 12, 0, 0, 0, time.UTC),
+				IndexerIDs: []int64{3, 4},
+			},
+			ResponseBody: starr.BodyNotFound,
+			WithError:    starr.ErrInvalidStatusCode,
+			WithResponse: (*prowlarr.IndexerStats)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := prowlarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.GetIndexerStats(test.WithRequest.(prowlarr.IndexerStatsFilter))
+			assert.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
@@ -14,6 +14,17 @@ type Prowlarr struct {
 // APIver is the Prowlarr API version supported by this library.
 const APIver = "v1"
 
+// Filter values are integers. Given names for ease of discovery.
+// https://github.com/Prowlarr/Prowlarr/blob/develop/src/NzbDrone.Core/History/History.cs
+const (
+	FilterUnknown starr.Filtering = iota
+	FilterReleaseGrabbed
+	FilterIndexerQuery
+	FilterIndexerRss
+	FilterIndexerAuth
+	FilterIndexerInfo
+)
+
 // New returns a Prowlarr object used to interact with the Prowlarr API.
 func New(config *starr.Config) *Prowlarr {
 	if config.Client == nil {
@@ -0,0 +1,138 @@
+package prowlarr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/craigjmidwinter/starr"
+)
+
+const bpSearch = APIver + "/search"
+
+// SearchType is an enum used as the search Type in a SearchInput.
+type SearchType string
+
+// SearchType enum constants. These mirror the newznab/torznab search functions.
+const (
+	SearchTypeSearch SearchType = "search"
+	SearchTypeTV     SearchType = "tvsearch"
+	SearchTypeMovie  SearchType = "movie"
+	SearchTypeMusic  SearchType = "music"
+	SearchTypeBook   SearchType = "book"
+)
+
+// SearchInput is the input for a search request. Only Query is required.
+// Leaving IndexerIDs empty searches every enabled indexer.
+type SearchInput struct {
+	Query      string     // Search term. Supports {ImdbId:tt1234567} style tokens.
+	Type       SearchType // Defaults to SearchTypeSearch.
+	Categories []int64    // Newznab category IDs, like 2000 or 5040.
+	IndexerIDs []int64    // Limit the search to these indexers.
+	Limit      int        // Maximum results per indexer. Prowlarr's default (100) is used if zero.
+	Offset     int        // Results offset, for paging.
+}
+
+// Search is a release returned from the search endpoint.
+type Search struct {
+	ID           int64              `json:"id,omitempty"`
+	GUID         string             `json:"guid"`
+	Age          int64              `json:"age"`
+	AgeHours     float64            `json:"ageHours"`
+	AgeMinutes   float64            `json:"ageMinutes"`
+	Size         int64              `json:"size"`
+	Files        int64              `json:"files,omitempty"`
+	Grabs        int64              `json:"grabs,omitempty"`
+	IndexerID    int64              `json:"indexerId"`
+	Indexer      string             `json:"indexer"`
+	SubGroup     string             `json:"subGroup,omitempty"`
+	ReleaseHash  string             `json:"releaseHash,omitempty"`
+	Title        string             `json:"title"`
+	SortTitle    string             `json:"sortTitle,omitempty"`
+	ImdbID       int64              `json:"imdbId,omitempty"`
+	TmdbID       int64              `json:"tmdbId,omitempty"`
+	TvdbID       int64              `json:"tvdbId,omitempty"`
+	TvMazeID     int64              `json:"tvMazeId,omitempty"`
+	PublishDate  time.Time          `json:"publishDate"`
+	CommentURL   string             `json:"commentUrl,omitempty"`
+	DownloadURL  string             `json:"downloadUrl,omitempty"`
+	InfoURL      string             `json:"infoUrl,omitempty"`
+	PosterURL    string             `json:"posterUrl,omitempty"`
+	IndexerFlags []string           `json:"indexerFlags,omitempty"`
+	Categories   []*IndexerCategory `json:"categories,omitempty"`
+	MagnetURL    string             `json:"magnetUrl,omitempty"`
+	InfoHash     string             `json:"infoHash,omitempty"`
+	Seeders      int64              `json:"seeders,omitempty"`
+	Leechers     int64              `json:"leechers,omitempty"`
+	Protocol     string             `json:"protocol"`
+	FileName     string             `json:"fileName,omitempty"`
+}
+
+// Search searches indexers through Prowlarr and returns the releases found.
+func (p *Prowlarr) Search(search SearchInput) ([]*Search, error) {
+	return p.SearchContext(context.Background(), search)
+}
+
+// SearchContext searches indexers through Prowlarr and returns the releases found.
+func (p *Prowlarr) SearchContext(ctx context.Context, search SearchInput) ([]*Search, error) {
+	if search.Type == "" {
+		search.Type = SearchTypeSearch
+	}
+
+	req := starr.Request{URI: bpSearch, Query: make(url.Values)}
+	req.Query.Set("query", search.Query)
+	req.Query.Set("type", string(search.Type))
+
+	for _, cat := range search.Categories {
+		req.Query.Add("categories", fmt.Sprint(cat))
+	}
+
+	for _, id := range search.IndexerIDs {
+		req.Query.Add("indexerIds", fmt.Sprint(id))
+	}
+
+	if search.Limit > 0 {
+		req.Query.Set("limit", fmt.Sprint(search.Limit))
+	}
+
+	if search.Offset > 0 {
+		req.Query.Set("offset", fmt.Sprint(search.Offset))
+	}
+
+	var output []*Search
+	if err := p.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// Grab sends a release found with Search() to the indexer's download client.
+// Only the GUID and IndexerID are required, and the release must have been
+// returned by a recent search, because Prowlarr looks it up in its search cache.
+func (p *Prowlarr) Grab(release *Search) (*Search, error) {
+	return p.GrabContext(context.Background(), release)
+}
+
+// GrabContext sends a release found with Search() to the indexer's download client.
+func (p *Prowlarr) GrabContext(ctx context.Context, release *Search) (*Search, error) {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(&struct {
+		GUID      string `json:"guid"`
+		IndexerID int64  `json:"indexerId"`
+	}{GUID: release.GUID, IndexerID: release.IndexerID}); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpSearch, err)
+	}
+
+	var output Search
+
+	req := starr.Request{URI: bpSearch, Body: &body}
+	if err := p.PostInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
@@ -0,0 +1,129 @@
+package prowlarr_test
+
+import (
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/craigjmidwinter/starr"
+	"github.com/craigjmidwinter/starr/prowlarr"
+)
+
+const searchResponseBody = `{
+	"guid": "https://example.com/details/123",
+	"age": 2,
+	"size": 1073741824,
+	"indexerId": 3,
+	"indexer": "Example",
+	"title": "Some.Release.1080p",
+	"publishDate": "2022-10-01T12:00:00Z",
+	"downloadUrl": "http://localhost:9696/3/download?link=abc",
+	"infoUrl": "https://example.com/details/123",
+	"seeders": 42,
+	"protocol": "torrent"
+  }`
+
+func TestSearch(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starr.TestMockData{
+		{
+			Name: "200",
+			ExpectedPath: path.Join("/", starr.API, prowlarr.APIver, "search") +
+				"?categories=2000&categories=5000&indexerIds=3&limit=50&query=release&type=search",
+			ExpectedMethod: "GET",
+			ResponseStatus: 200,
+			WithRequest: prowlarr.SearchInput{
+				Query:      "release",
+				Categories: []int64{2000, 5000},
+				IndexerIDs: []int64{3},
+				Limit:      50,
+			},
+			ResponseBody: "[" + searchResponseBody + "]",
+			WithResponse: []*prowlarr.Search{
+				{
+					GUID:        "https://example.com/details/123",
+					Age:         2,
+					Size:        1073741824,
+					IndexerID:   3,
+					Indexer:     "Example",
+					Title:       "Some.Release.1080p",
+					PublishDate: time.Date(2022, 10, 1, 12, 0, 0, 0, time.UTC),
+					DownloadURL: "http://localhost:9696/3/download?link=abc",
+					InfoURL:     "https://example.com/details/123",
+					Seeders:     42,
+					Protocol:    "torrent",
+				},
+			},
+			WithError: nil,
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, prowlarr.APIver, "search") + "?query=release&type=tvsearch",
+			ExpectedMethod: "GET",
+			ResponseStatus: 404,
+			WithRequest:    prowlarr.SearchInput{Query: "release", Type: prowlarr.SearchTypeTV},
+			ResponseBody:   starr.BodyNotFound,
+			WithError:      starr.ErrInvalidStatusCode,
+			WithResponse:   ([]*prowlarr.Search)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := prowlarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.Search(test.WithRequest.(prowlarr.SearchInput))
+			assert.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
+
+func TestGrab(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starr.TestMockData{
+		{
+			Name:            "200",
+			ExpectedPath:    path.Join("/", starr.API, prowlarr.APIver, "search"),
+			ExpectedMethod:  "POST",
+			ResponseStatus:  200,
+			WithRequest:     &prowlarr.Search{GUID: "https://example.com/details/123", IndexerID: 3, Title: "ignored"},
+			ExpectedRequest: `{"guid":"https://example.com/details/123","indexerId":3}` + "\n",
+			ResponseBody:    `{"guid": "https://example.com/details/123", "indexerId": 3, "title": "Some.Release.1080p"}`,
+			WithResponse: &prowlarr.Search{
+				GUID:      "https://example.com/details/123",
+				IndexerID: 3,
+				Title:     "Some.Release.1080p",
+			},
+			WithError: nil,
+		},
+		{
+			Name:            "404",
+			ExpectedPath:    path.Join("/", starr.API, prowlarr.APIver, "search"),
+			ExpectedMethod:  "POST",
+			ResponseStatus:  404,
+			WithRequest:     &prowlarr.Search{GUID: "https://example.com/details/123", IndexerID: 3},
+			ExpectedRequest: `{"guid":"https://example.com/details/123","indexerId":3}` + "\n",
+			ResponseBody:    starr.BodyNotFound,
+			WithError:       starr.ErrInvalidStatusCode,
+			WithResponse:    (*prowlarr.Search)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := prowlarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.Grab(test.WithRequest.(*prowlarr.Search))
+			assert.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
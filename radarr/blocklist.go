@@ -0,0 +1,168 @@
+package radarr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"path"
+	"time"
+
+	"github.com/craigjmidwinter/starr"
+)
+
+const bpBlocklist = APIver + "/blocklist"
+
+// Blocklist is the /api/v3/blocklist endpoint.
+type Blocklist struct {
+	Page          int                `json:"page"`
+	PageSize      int                `json:"pageSize"`
+	SortKey       string             `json:"sortKey"`
+	SortDirection string             `json:"sortDirection"`
+	TotalRecords  int                `json:"totalRecords"`
+	Records       []*BlocklistRecord `json:"records"`
+}
+
+// BlocklistRecord is a release that Radarr will not grab again.
+type BlocklistRecord struct {
+	ID            int64           `json:"id"`
+	MovieID       int64           `json:"movieId"`
+	SourceTitle   string          `json:"sourceTitle"`
+	Languages     []*starr.Value  `json:"languages"`
+	Quality       *starr.Quality  `json:"quality"`
+	CustomFormats []*CustomFormat `json:"customFormats"`
+	Date          time.Time       `json:"date"`
+	Protocol      string          `json:"protocol"`
+	Indexer       string          `json:"indexer"`
+	Message       string          `json:"message"`
+	Movie         *Movie          `json:"movie,omitempty"`
+}
+
+// GetBlocklist returns the Radarr blocklist.
+// This function simply returns the number of blocklist records desired,
+// up to the number of records present in the application.
+// It grabs records in (paginated) batches of perPage, and concatenates
+// them into one list.  Passing zero for records will return all of them.
+func (r *Radarr) GetBlocklist(records, perPage int) (*Blocklist, error) {
+	return r.GetBlocklistContext(context.Background(), records, perPage)
+}
+
+// GetBlocklistContext returns the Radarr blocklist.
+func (r *Radarr) GetBlocklistContext(ctx context.Context, records, perPage int) (*Blocklist, error) {
+	blocklist := &Blocklist{Records: []*BlocklistRecord{}}
+
+	err := starr.Paginate(ctx, &starr.PageReq{PageSize: perPage}, records,
+		func(ctx context.Context, params *starr.PageReq) (int, int, error) {
+			curr, err := r.GetBlocklistPageContext(ctx, params)
+			if err != nil {
+				return 0, 0, err
+			}
+
+			blocklist.Records = append(blocklist.Records, curr.Records...)
+			blocklist.PageSize = curr.TotalRecords
+			blocklist.TotalRecords = curr.TotalRecords
+			blocklist.SortDirection = curr.SortDirection
+			blocklist.SortKey = curr.SortKey
+
+			return len(curr.Records), curr.TotalRecords, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	// The last page may return more records than requested.
+	if records > 0 && len(blocklist.Records) > records {
+		blocklist.Records = blocklist.Records[:records]
+	}
+
+	return blocklist, nil
+}
+
+// GetBlocklistPage returns a single page from the Radarr blocklist.
+// The page size and number is configurable with the input request parameters.
+// Filter the list to specific movies with params.Values = url.Values{"movieIds": {"1", "2", "3"}}.
+func (r *Radarr) GetBlocklistPage(params *starr.PageReq) (*Blocklist, error) {
+	return r.GetBlocklistPageContext(context.Background(), params)
+}
+
+// GetBlocklistPageContext returns a single page from the Radarr blocklist.
+func (r *Radarr) GetBlocklistPageContext(ctx context.Context, params *starr.PageReq) (*Blocklist, error) {
+	var output Blocklist
+
+	req := starr.Request{URI: bpBlocklist, Query: params.Params()}
+	if err := r.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// GetBlocklistForMovie returns every blocklisted release for a single movie.
+func (r *Radarr) GetBlocklistForMovie(movieID int64) ([]*BlocklistRecord, error) {
+	return r.GetBlocklistForMovieContext(context.Background(), movieID)
+}
+
+// GetBlocklistForMovieContext returns every blocklisted release for a single movie.
+func (r *Radarr) GetBlocklistForMovieContext(ctx context.Context, movieID int64) ([]*BlocklistRecord, error) {
+	var output []*BlocklistRecord
+
+	req := starr.Request{URI: path.Join(bpBlocklist, "movie"), Query: make(url.Values)}
+	req.Query.Add("movieId", fmt.Sprint(movieID))
+
+	if err := r.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// DeleteBlocklist removes a single blocklist item.
+func (r *Radarr) DeleteBlocklist(blocklistID int64) error {
+	return r.DeleteBlocklistContext(context.Background(), blocklistID)
+}
+
+// DeleteBlocklistContext removes a single blocklist item.
+func (r *Radarr) DeleteBlocklistContext(ctx context.Context, blocklistID int64) error {
+	req := starr.Request{URI: path.Join(bpBlocklist, fmt.Sprint(blocklistID))}
+	if err := r.DeleteAny(ctx, req); err != nil {
+		return fmt.Errorf("api.Delete(%s): %w", &req, err)
+	}
+
+	return nil
+}
+
+// DeleteBlocklists bulk removes blocklist items by their IDs.
+func (r *Radarr) DeleteBlocklists(blocklistIDs []int64) error {
+	return r.DeleteBlocklistsContext(context.Background(), blocklistIDs)
+}
+
+// DeleteBlocklistsContext bulk removes blocklist items by their IDs.
+func (r *Radarr) DeleteBlocklistsContext(ctx context.Context, blocklistIDs []int64) error {
+	postData := struct {
+		IDs []int64 `json:"ids"`
+	}{blocklistIDs}
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(&postData); err != nil {
+		return fmt.Errorf("json.Marshal(%s): %w", bpBlocklist, err)
+	}
+
+	req := starr.Request{URI: path.Join(bpBlocklist, "bulk"), Body: &body}
+	if err := r.DeleteAny(ctx, req); err != nil {
+		return fmt.Errorf("api.Delete(%s): %w", &req, err)
+	}
+
+	return nil
+}
+
+// ClearBlocklist removes every item from the blocklist. Radarr does this with a command,
+// so the blocklist may not be empty until the returned command finishes.
+func (r *Radarr) ClearBlocklist() (*CommandResponse, error) {
+	return r.ClearBlocklistContext(context.Background())
+}
+
+// ClearBlocklistContext removes every item from the blocklist.
+func (r *Radarr) ClearBlocklistContext(ctx context.Context) (*CommandResponse, error) {
+	return r.SendCommandContext(ctx, &CommandRequest{Name: "ClearBlocklist"})
+}
@@ -0,0 +1,127 @@
+package radarr_test
+
+import (
+	"net/http"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/craigjmidwinter/starr"
+	"github.com/craigjmidwinter/starr/radarr"
+)
+
+func TestGetBlocklistPage(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starr.TestMockData{
+		{
+			Name: "200",
+			ExpectedPath: path.Join("/", starr.API, radarr.APIver, "blocklist") +
+				"?movieIds=17&page=1&pageSize=10&sortDirection=descending&sortKey=date",
+			ResponseStatus: http.StatusOK,
+			ResponseBody: `{"page":1,"pageSize":10,"sortKey":"date","sortDirection":"descending","totalRecords":1,` +
+				`"records":[{"id":3,"movieId":17,"sourceTitle":"Some.Film.2021","date":"2022-01-02T03:04:05Z",` +
+				`"protocol":"torrent","indexer":"Tracker","message":"Manually marked as failed"}]}`,
+			WithRequest: &starr.PageReq{
+				SortDir: starr.SortDescend,
+				Values:  map[string][]string{"movieIds": {"17"}},
+			},
+			WithError:      nil,
+			ExpectedMethod: http.MethodGet,
+			WithResponse: &radarr.Blocklist{
+				Page:          1,
+				PageSize:      10,
+				SortKey:       "date",
+				SortDirection: "descending",
+				TotalRecords:  1,
+				Records: []*radarr.BlocklistRecord{{
+					ID:          3,
+					MovieID:     17,
+					SourceTitle: "Some.Film.2021",
+					Date:        time.Date(2022, 1, 2, 3, 4, 5, 0, time.UTC),
+					Protocol:    "torrent",
+					Indexer:     "Tracker",
+					Message:     "Manually marked as failed",
+				}},
+			},
+		},
+		{
+			Name: "200 two movies",
+			ExpectedPath: path.Join("/", starr.API, radarr.APIver, "blocklist") +
+				"?movieIds=17&movieIds=18&page=1&pageSize=10&sortDirection=ascending&sortKey=date",
+			ResponseStatus: http.StatusOK,
+			ResponseBody:   `{"page":1,"pageSize":10,"sortKey":"date","sortDirection":"ascending","totalRecords":0,"records":[]}`,
+			WithRequest:    &starr.PageReq{Values: map[string][]string{"movieIds": {"17", "18"}}},
+			WithError:      nil,
+			ExpectedMethod: http.MethodGet,
+			WithResponse: &radarr.Blocklist{
+				Page:          1,
+				PageSize:      10,
+				SortKey:       "date",
+				SortDirection: "ascending",
+				Records:       []*radarr.BlocklistRecord{},
+			},
+		},
+		{
+			Name: "404",
+			ExpectedPath: path.Join("/", starr.API, radarr.APIver, "blocklist") +
+				"?page=1&pageSize=10&sortDirection=ascending&sortKey=date",
+			ResponseStatus: http.StatusNotFound,
+			ResponseBody:   `{"message": "NotFound"}`,
+			WithRequest:    &starr.PageReq{},
+			WithError:      starr.ErrInvalidStatusCode,
+			ExpectedMethod: http.MethodGet,
+			WithResponse:   (*radarr.Blocklist)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := radarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.GetBlocklistPage(test.WithRequest.(*starr.PageReq))
+			assert.ErrorIs(t, err, test.WithError, "the wrong error was returned")
+			assert.EqualValues(t, test.WithResponse, output, "make sure ResponseBody and WithResponse are a match")
+		})
+	}
+}
+
+func TestDeleteBlocklists(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starr.TestMockData{
+		{
+			Name:            "200",
+			ExpectedPath:    path.Join("/", starr.API, radarr.APIver, "blocklist", "bulk"),
+			ResponseStatus:  http.StatusOK,
+			WithRequest:     []int64{3, 4},
+			ExpectedRequest: `{"ids":[3,4]}` + "\n",
+			WithError:       nil,
+			ExpectedMethod:  http.MethodDelete,
+		},
+		{
+			Name:            "404",
+			ExpectedPath:    path.Join("/", starr.API, radarr.APIver, "blocklist", "bulk"),
+			ResponseStatus:  http.StatusNotFound,
+			ResponseBody:    `{"message": "NotFound"}`,
+			WithRequest:     []int64{3},
+			ExpectedRequest: `{"ids":[3]}` + "\n",
+			WithError:       starr.ErrInvalidStatusCode,
+			ExpectedMethod:  http.MethodDelete,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := radarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			err := client.DeleteBlocklists(test.WithRequest.([]int64))
+			assert.ErrorIs(t, err, test.WithError, "the wrong error was returned")
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package radarr
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/craigjmidwinter/starr"
+)
+
+// Define Base Path for Calendar queries.
+const bpCalendar = APIver + "/calendar"
+
+// Calendar defines the filters for fetching calendar items.
+// Movies are returned if their in cinemas, digital or physical release date falls between Start and End.
+type Calendar struct {
+	Start       time.Time
+	End         time.Time
+	Unmonitored bool
+	Tags        []int // Only return movies with at least one of these tag IDs.
+}
+
+// GetCalendar returns calendars based on filters.
+func (r *Radarr) GetCalendar(filter Calendar) ([]*Movie, error) {
+	return r.GetCalendarContext(context.Background(), filter)
+}
+
+// GetCalendarContext returns calendars based on filters.
+func (r *Radarr) GetCalendarContext(ctx context.Context, filter Calendar) ([]*Movie, error) {
+	var output []*Movie
+
+	req := starr.Request{URI: bpCalendar, Query: make(url.Values)}
+	req.Query.Add("unmonitored", fmt.Sprint(filter.Unmonitored))
+
+	if !filter.Start.IsZero() {
+		req.Query.Add("start", filter.Start.UTC().Format(starr.CalendarTimeFilterFormat))
+	}
+
+	if !filter.End.IsZero() {
+		req.Query.Add("end", filter.End.UTC().Format(starr.CalendarTimeFilterFormat))
+	}
+
+	if len(filter.Tags) > 0 {
+		tags := make([]string, len(filter.Tags))
+		for idx, tag := range filter.Tags {
+			tags[idx] = fmt.Sprint(tag)
+		}
+
+		req.Query.Add("tags", strings.Join(tags, ","))
+	}
+
+	if err := r.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// GetCalendarID returns a single calendar by ID.
+func (r *Radarr) GetCalendarID(calendarID int64) (*Movie, error) {
+	return r.GetCalendarIDContext(context.Background(), calendarID)
+}
+
+// GetCalendarIDContext returns a single calendar by ID.
+func (r *Radarr) GetCalendarIDContext(ctx context.Context, calendarID int64) (*Movie, error) {
+	var output *Movie
+
+	req := starr.Request{URI: path.Join(bpCalendar, fmt.Sprint(calendarID))}
+	if err := r.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,135 @@
+package radarr_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/craigjmidwinter/starr"
+	"github.com/craigjmidwinter/starr/radarr"
+)
+
+const testCalendarJSON = `{
+	"title": "The Matrix Resurrections",
+	"originalTitle": "The Matrix Resurrections",
+	"sortTitle": "matrix resurrections",
+	"status": "released",
+	"inCinemas": "2021-12-16T00:00:00Z",
+	"physicalRelease": "2022-03-01T00:00:00Z",
+	"digitalRelease": "2021-12-22T00:00:00Z",
+	"year": 2021,
+	"path": "/movies/The Matrix Resurrections (2021)",
+	"qualityProfileId": 1,
+	"hasFile": false,
+	"monitored": true,
+	"tmdbId": 624860,
+	"tags": [2],
+	"popularity": 0,
+	"id": 17
+}`
+
+// This matches the json above.
+var testCalendarStruct = radarr.Movie{
+	ID:               17,
+	Title:            "The Matrix Resurrections",
+	OriginalTitle:    "The Matrix Resurrections",
+	SortTitle:        "matrix resurrections",
+	Status:           "released",
+	InCinemas:        time.Date(2021, 12, 16, 0, 0, 0, 0, time.UTC),
+	PhysicalRelease:  time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC),
+	DigitalRelease:   time.Date(2021, 12, 22, 0, 0, 0, 0, time.UTC),
+	Year:             2021,
+	Path:             "/movies/The Matrix Resurrections (2021)",
+	QualityProfileID: 1,
+	Monitored:        true,
+	TmdbID:           624860,
+	Tags:             []int{2},
+}
+
+func TestGetCalendar(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starr.TestMockData{
+		{
+			Name: "200",
+			ExpectedPath: "/api/v3/calendar" +
+				"?end=2020-02-20T04%3A20%3A20.000Z" +
+				"&start=2020-02-20T04%3A20%3A20.000Z" +
+				"&tags=2%2C5" +
+				"&unmonitored=true",
+			ResponseStatus: http.StatusOK,
+			ResponseBody:   `[` + testCalendarJSON + `]`,
+			WithRequest: radarr.Calendar{
+				Start:       time.Unix(1582172420, 0),
+				End:         time.Unix(1582172420, 0),
+				Unmonitored: true,
+				Tags:        []int{2, 5},
+			},
+			WithError:      nil,
+			ExpectedMethod: http.MethodGet,
+			WithResponse:   []*radarr.Movie{&testCalendarStruct},
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   "/api/v3/calendar?unmonitored=false",
+			ResponseStatus: http.StatusNotFound,
+			ResponseBody:   `{"message": "NotFound"}`,
+			WithError:      starr.ErrInvalidStatusCode,
+			ExpectedMethod: http.MethodGet,
+			WithRequest:    radarr.Calendar{},
+			WithResponse:   []*radarr.Movie(nil),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := radarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.GetCalendar(test.WithRequest.(radarr.Calendar))
+			assert.ErrorIs(t, err, test.WithError, "the wrong error was returned")
+			assert.EqualValues(t, test.WithResponse, output, "make sure ResponseBody and WithResponse are a match")
+		})
+	}
+}
+
+func TestGetCalendarID(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starr.TestMockData{
+		{
+			Name:           "200",
+			ExpectedPath:   "/api/v3/calendar/17",
+			ResponseStatus: http.StatusOK,
+			ResponseBody:   testCalendarJSON,
+			WithRequest:    int64(17),
+			WithError:      nil,
+			ExpectedMethod: http.MethodGet,
+			WithResponse:   &testCalendarStruct,
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   "/api/v3/calendar/17",
+			ResponseStatus: http.StatusNotFound,
+			ResponseBody:   `{"message": "NotFound"}`,
+			WithError:      starr.ErrInvalidStatusCode,
+			ExpectedMethod: http.MethodGet,
+			WithRequest:    int64(17),
+			WithResponse:   (*radarr.Movie)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := radarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.GetCalendarID(test.WithRequest.(int64))
+			assert.ErrorIs(t, err, test.WithError, "the wrong error was returned")
+			assert.EqualValues(t, test.WithResponse, output, "make sure ResponseBody and WithResponse are a match")
+		})
+	}
+}
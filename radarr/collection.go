@@ -0,0 +1,147 @@
+package radarr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"path"
+
+	"github.com/craigjmidwinter/starr"
+)
+
+const bpCollection = APIver + "/collection"
+
+// Collection is a TMDb collection (franchise). It belongs to a Movie, and is the output from
+// the /api/v3/collection endpoint. Movies only include the name (or title), tmdbId and images.
+type Collection struct {
+	ID                  int64              `json:"id,omitempty"`
+	Name                string             `json:"name,omitempty"`
+	Title               string             `json:"title,omitempty"`
+	SortTitle           string             `json:"sortTitle,omitempty"`
+	TmdbID              int64              `json:"tmdbId"`
+	Images              []*starr.Image     `json:"images"`
+	Overview            string             `json:"overview,omitempty"`
+	Monitored           bool               `json:"monitored,omitempty"`
+	RootFolderPath      string             `json:"rootFolderPath,omitempty"`
+	QualityProfileID    int64              `json:"qualityProfileId,omitempty"`
+	SearchOnAdd         bool               `json:"searchOnAdd,omitempty"`
+	MinimumAvailability Availability       `json:"minimumAvailability,omitempty"`
+	Movies              []*CollectionMovie `json:"movies,omitempty"`
+	MissingMovies       int64              `json:"missingMovies,omitempty"`
+	Tags                []int              `json:"tags,omitempty"`
+}
+
+// CollectionMovie is part of a Collection. IsExisting is true if the movie is in Radarr already.
+type CollectionMovie struct {
+	TmdbID     int64             `json:"tmdbId"`
+	ImdbID     string            `json:"imdbId"`
+	Title      string            `json:"title"`
+	CleanTitle string            `json:"cleanTitle"`
+	SortTitle  string            `json:"sortTitle"`
+	Status     string            `json:"status"`
+	Overview   string            `json:"overview"`
+	Runtime    int               `json:"runtime"`
+	Images     []*starr.Image    `json:"images"`
+	Year       int               `json:"year"`
+	Ratings    starr.OpenRatings `json:"ratings"`
+	Genres     []string          `json:"genres"`
+	Folder     string            `json:"folder"`
+	IsExisting bool              `json:"isExisting"`
+	IsExcluded bool              `json:"isExcluded"`
+}
+
+// CollectionEditor is the input for the bulk collection editor.
+// You may use starr.True(), starr.False(), starr.Int64(), and starr.String() to add data to the struct members.
+type CollectionEditor struct {
+	CollectionIDs       []int64       `json:"collectionIds"`
+	Monitored           *bool         `json:"monitored,omitempty"`
+	MonitorMovies       *bool         `json:"monitorMovies,omitempty"` // also (un)monitor every movie in the collections.
+	SearchOnAdd         *bool         `json:"searchOnAdd,omitempty"`
+	QualityProfileID    *int64        `json:"qualityProfileId,omitempty"`
+	RootFolderPath      *string       `json:"rootFolderPath,omitempty"`
+	MinimumAvailability *Availability `json:"minimumAvailability,omitempty"`
+}
+
+// GetCollections returns all collections, or only the collection with the provided TMDb ID if it's not 0.
+func (r *Radarr) GetCollections(tmdbID int64) ([]*Collection, error) {
+	return r.GetCollectionsContext(context.Background(), tmdbID)
+}
+
+// GetCollectionsContext returns all collections, or only the collection with the provided TMDb ID if it's not 0.
+func (r *Radarr) GetCollectionsContext(ctx context.Context, tmdbID int64) ([]*Collection, error) {
+	var output []*Collection
+
+	req := starr.Request{URI: bpCollection, Query: make(url.Values)}
+	if tmdbID != 0 {
+		req.Query.Add("tmdbId", fmt.Sprint(tmdbID))
+	}
+
+	if err := r.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// GetCollection returns a single collection by its Radarr ID.
+func (r *Radarr) GetCollection(collectionID int64) (*Collection, error) {
+	return r.GetCollectionContext(context.Background(), collectionID)
+}
+
+// GetCollectionContext returns a single collection by its Radarr ID.
+func (r *Radarr) GetCollectionContext(ctx context.Context, collectionID int64) (*Collection, error) {
+	var output Collection
+
+	req := starr.Request{URI: path.Join(bpCollection, fmt.Sprint(collectionID))}
+	if err := r.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// UpdateCollection updates a collection; usually its monitored flag, quality profile or root folder.
+func (r *Radarr) UpdateCollection(collection *Collection) (*Collection, error) {
+	return r.UpdateCollectionContext(context.Background(), collection)
+}
+
+// UpdateCollectionContext updates a collection; usually its monitored flag, quality profile or root folder.
+func (r *Radarr) UpdateCollectionContext(ctx context.Context, collection *Collection) (*Collection, error) {
+	var output Collection
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(collection); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpCollection, err)
+	}
+
+	req := starr.Request{URI: path.Join(bpCollection, fmt.Sprint(collection.ID)), Body: &body}
+	if err := r.PutInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Put(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// EditCollections allows bulk editing many collections at once.
+func (r *Radarr) EditCollections(editor *CollectionEditor) ([]*Collection, error) {
+	return r.EditCollectionsContext(context.Background(), editor)
+}
+
+// EditCollectionsContext allows bulk editing many collections at once.
+func (r *Radarr) EditCollectionsContext(ctx context.Context, editor *CollectionEditor) ([]*Collection, error) {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(editor); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpCollection, err)
+	}
+
+	var output []*Collection
+
+	req := starr.Request{URI: bpCollection, Body: &body}
+	if err := r.PutInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Put(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
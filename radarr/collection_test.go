@@ -0,0 +1,156 @@
+package radarr_test
+
+import (
+	"net/http"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/craigjmidwinter/starr"
+	"github.com/craigjmidwinter/starr/radarr"
+)
+
+const testCollectionJSON = `{
+	"id": 4,
+	"title": "The Matrix Collection",
+	"tmdbId": 2344,
+	"images": [],
+	"monitored": true,
+	"rootFolderPath": "/movies",
+	"qualityProfileId": 1,
+	"minimumAvailability": "released",
+	"movies": [{"tmdbId": 603, "title": "The Matrix", "year": 1999, "isExisting": true}],
+	"missingMovies": 3
+}`
+
+func TestGetCollections(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starr.TestMockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, radarr.APIver, "collection") + "?tmdbId=2344",
+			ResponseStatus: http.StatusOK,
+			ResponseBody:   `[` + testCollectionJSON + `]`,
+			WithRequest:    int64(2344),
+			WithError:      nil,
+			ExpectedMethod: http.MethodGet,
+			WithResponse: []*radarr.Collection{{
+				ID:                  4,
+				Title:               "The Matrix Collection",
+				TmdbID:              2344,
+				Images:              []*starr.Image{},
+				Monitored:           true,
+				RootFolderPath:      "/movies",
+				QualityProfileID:    1,
+				MinimumAvailability: radarr.AvailabilityReleased,
+				Movies:              []*radarr.CollectionMovie{{TmdbID: 603, Title: "The Matrix", Year: 1999, IsExisting: true}},
+				MissingMovies:       3,
+			}},
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, radarr.APIver, "collection"),
+			ResponseStatus: http.StatusNotFound,
+			ResponseBody:   `{"message": "NotFound"}`,
+			WithRequest:    int64(0),
+			WithError:      starr.ErrInvalidStatusCode,
+			ExpectedMethod: http.MethodGet,
+			WithResponse:   []*radarr.Collection(nil),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := radarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.GetCollections(test.WithRequest.(int64))
+			assert.ErrorIs(t, err, test.WithError, "the wrong error was returned")
+			assert.EqualValues(t, test.WithResponse, output, "make sure ResponseBody and WithResponse are a match")
+		})
+	}
+}
+
+func TestEditCollections(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starr.TestMockData{
+		{
+			Name:           "202",
+			ExpectedPath:   path.Join("/", starr.API, radarr.APIver, "collection"),
+			ResponseStatus: http.StatusAccepted,
+			ResponseBody:   `[{"id":4,"tmdbId":2344,"monitored":true,"images":null}]`,
+			WithRequest: &radarr.CollectionEditor{
+				CollectionIDs:  []int64{4},
+				Monitored:      starr.True(),
+				MonitorMovies:  starr.True(),
+				RootFolderPath: starr.String("/movies"),
+			},
+			ExpectedRequest: `{"collectionIds":[4],"monitored":true,"monitorMovies":true,"rootFolderPath":"/movies"}` + "\n",
+			WithError:       nil,
+			ExpectedMethod:  http.MethodPut,
+			WithResponse:    []*radarr.Collection{{ID: 4, TmdbID: 2344, Monitored: true}},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := radarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.EditCollections(test.WithRequest.(*radarr.CollectionEditor))
+			assert.ErrorIs(t, err, test.WithError, "the wrong error was returned")
+			assert.EqualValues(t, test.WithResponse, output, "make sure ResponseBody and WithResponse are a match")
+		})
+	}
+}
+
+func TestGetCredits(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starr.TestMockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, radarr.APIver, "credit") + "?movieId=17",
+			ResponseStatus: http.StatusOK,
+			ResponseBody: `[{"id":1,"personName":"Keanu Reeves","personTmdbId":6384,"character":"Neo","order":0,"type":"cast"},` +
+				`{"id":2,"personName":"Lana Wachowski","personTmdbId":9340,"department":"Directing","job":"Director",` +
+				`"order":0,"type":"crew"}]`,
+			WithRequest:    int64(17),
+			WithError:      nil,
+			ExpectedMethod: http.MethodGet,
+			WithResponse: []*radarr.Credit{
+				{ID: 1, PersonName: "Keanu Reeves", PersonTmdbID: 6384, Character: "Neo", Type: radarr.CreditTypeCast},
+				{
+					ID: 2, PersonName: "Lana Wachowski", PersonTmdbID: 9340,
+					Department: "Directing", Job: "Director", Type: radarr.CreditTypeCrew,
+				},
+			},
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, radarr.APIver, "credit") + "?movieId=17",
+			ResponseStatus: http.StatusNotFound,
+			ResponseBody:   `{"message": "NotFound"}`,
+			WithRequest:    int64(17),
+			WithError:      starr.ErrInvalidStatusCode,
+			ExpectedMethod: http.MethodGet,
+			WithResponse:   []*radarr.Credit(nil),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := radarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.GetCredits(test.WithRequest.(int64))
+			assert.ErrorIs(t, err, test.WithError, "the wrong error was returned")
+			assert.EqualValues(t, test.WithResponse, output, "make sure ResponseBody and WithResponse are a match")
+		})
+	}
+}
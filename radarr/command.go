@@ -14,9 +14,14 @@ const bpCommand = APIver + "/command"
 
 // CommandRequest goes into the /api/v3/command endpoint.
 // This was created from the search command and may not support other commands yet.
+// ManualImport and ImportMode are only used with the ManualImport command.
 type CommandRequest struct {
-	Name     string  `json:"name"`
-	MovieIDs []int64 `json:"movieIds,omitempty"`
+	Name         string               `json:"name"`
+	Files        []int64              `json:"files,omitempty"` // RenameFiles only
+	MovieIDs     []int64              `json:"movieIds,omitempty"`
+	MovieID      int64                `json:"movieId,omitempty"`
+	ManualImport []*ManualImportInput `json:"-"`
+	ImportMode   starr.ImportMode     `json:"importMode,omitempty"`
 }
 
 // CommandResponse comes from the /api/v3/command endpoint.
@@ -39,6 +44,20 @@ type CommandResponse struct {
 	Body                map[string]interface{} `json:"body"`
 }
 
+// MarshalJSON sends ManualImport as "files". RenameFiles uses the same key for Files.
+func (c CommandRequest) MarshalJSON() ([]byte, error) {
+	type command CommandRequest // avoid recursion.
+
+	if len(c.ManualImport) == 0 {
+		return json.Marshal((*command)(&c)) //nolint:wrapcheck
+	}
+
+	return json.Marshal(&struct { //nolint:wrapcheck
+		*command
+		Files []*ManualImportInput `json:"files"`
+	}{command: (*command)(&c), Files: c.ManualImport})
+}
+
 // GetCommands returns all available Radarr commands.
 func (r *Radarr) GetCommands() ([]*CommandResponse, error) {
 	return r.GetCommandsContext(context.Background())
@@ -81,3 +100,45 @@ func (r *Radarr) SendCommandContext(ctx context.Context, cmd *CommandRequest) (*
 
 	return &output, nil
 }
+
+// sendBatches sends a command for the provided IDs in batches of batchSize, waiting for delay between each batch.
+// A batchSize of zero sends every ID in a single command. The responses for every command sent are returned.
+func (r *Radarr) sendBatches(
+	ctx context.Context,
+	name string,
+	movieIDs []int64,
+	batchSize int,
+	delay time.Duration,
+) ([]*CommandResponse, error) {
+	if batchSize <= 0 {
+		batchSize = len(movieIDs)
+	}
+
+	output := []*CommandResponse{}
+
+	for start := 0; start < len(movieIDs); start += batchSize {
+		if start > 0 && delay > 0 {
+			timer := time.NewTimer(delay)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return output, fmt.Errorf("waiting for next %s batch: %w", name, ctx.Err())
+			case <-timer.C:
+			}
+		}
+
+		end := start + batchSize
+		if end > len(movieIDs) {
+			end = len(movieIDs)
+		}
+
+		resp, err := r.SendCommandContext(ctx, &CommandRequest{Name: name, MovieIDs: movieIDs[start:end]})
+		if err != nil {
+			return output, err
+		}
+
+		output = append(output, resp)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,55 @@
+package radarr
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/craigjmidwinter/starr"
+)
+
+const bpCredit = APIver + "/credit"
+
+// CreditType is an enum used as the Type in a Credit.
+type CreditType string
+
+// CreditType enum constants.
+const (
+	CreditTypeCast CreditType = "cast"
+	CreditTypeCrew CreditType = "crew"
+)
+
+// Credit is a cast or crew member returned from the /api/v3/credit endpoint.
+// Character is only set for cast, Department and Job are only set for crew.
+type Credit struct {
+	ID              int64          `json:"id"`
+	PersonName      string         `json:"personName"`
+	CreditTmdbID    string         `json:"creditTmdbId"`
+	PersonTmdbID    int64          `json:"personTmdbId"`
+	MovieMetadataID int64          `json:"movieMetadataId"`
+	Images          []*starr.Image `json:"images"`
+	Department      string         `json:"department,omitempty"`
+	Job             string         `json:"job,omitempty"`
+	Character       string         `json:"character,omitempty"`
+	Order           int            `json:"order"`
+	Type            CreditType     `json:"type"`
+}
+
+// GetCredits returns the cast and crew for a movie.
+func (r *Radarr) GetCredits(movieID int64) ([]*Credit, error) {
+	return r.GetCreditsContext(context.Background(), movieID)
+}
+
+// GetCreditsContext returns the cast and crew for a movie.
+func (r *Radarr) GetCreditsContext(ctx context.Context, movieID int64) ([]*Credit, error) {
+	var output []*Credit
+
+	req := starr.Request{URI: bpCredit, Query: make(url.Values)}
+	req.Query.Add("movieId", fmt.Sprint(movieID))
+
+	if err := r.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,96 @@
+package radarr
+
+import (
+	"context"
+
+	"github.com/craigjmidwinter/starr"
+)
+
+// Event names Radarr sends on its event stream. Compare these with starr.Event.Name.
+const (
+	EventQueue       = "queue"
+	EventQueueStatus = "queue/status"
+	EventCommand     = "command"
+	EventMovie       = "movie"
+	EventMovieFile   = "moviefile"
+	EventHealth      = "health"
+)
+
+// QueueStatus is the summary of the queue sent with a queue/status event.
+type QueueStatus struct {
+	TotalCount      int64 `json:"totalCount"`
+	Count           int64 `json:"count"`
+	UnknownCount    int64 `json:"unknownCount"`
+	Errors          bool  `json:"errors"`
+	Warnings        bool  `json:"warnings"`
+	UnknownErrors   bool  `json:"unknownErrors"`
+	UnknownWarnings bool  `json:"unknownWarnings"`
+}
+
+// QueueEvent is sent when the queue changes. Record is nil when the whole queue needs a refresh (action sync).
+type QueueEvent struct {
+	Action starr.EventAction
+	Record *QueueRecord
+}
+
+// QueueStatusEvent is sent when the queue counters change.
+type QueueStatusEvent struct {
+	Action starr.EventAction
+	Status *QueueStatus
+}
+
+// CommandEvent is sent when a command is queued, makes progress or finishes.
+type CommandEvent struct {
+	Action  starr.EventAction
+	Command *CommandResponse
+}
+
+// MovieEvent is sent when a movie is added, updated or deleted.
+type MovieEvent struct {
+	Action starr.EventAction
+	Movie  *Movie
+}
+
+// MovieFileEvent is sent when a movie file is imported, changed or deleted.
+type MovieFileEvent struct {
+	Action    starr.EventAction
+	MovieFile *MovieFile
+}
+
+// HealthEvent is sent when the health checks change. Call GetSystemStatus or the health endpoint for details.
+type HealthEvent struct {
+	Action starr.EventAction
+}
+
+// ParseEvent turns a raw stream event into one of the typed events in this package.
+// Unknown events are returned as the provided *starr.Event.
+func ParseEvent(event *starr.Event) (interface{}, error) {
+	switch event.Name {
+	case EventQueue:
+		output := &QueueEvent{Action: event.Action}
+		return output, event.Resource(&output.Record)
+	case EventQueueStatus:
+		output := &QueueStatusEvent{Action: event.Action}
+		return output, event.Resource(&output.Status)
+	case EventCommand:
+		output := &CommandEvent{Action: event.Action}
+		return output, event.Resource(&output.Command)
+	case EventMovie:
+		output := &MovieEvent{Action: event.Action}
+		return output, event.Resource(&output.Movie)
+	case EventMovieFile:
+		output := &MovieFileEvent{Action: event.Action}
+		return output, event.Resource(&output.MovieFile)
+	case EventHealth:
+		return &HealthEvent{Action: event.Action}, nil
+	default:
+		return event, nil
+	}
+}
+
+// StreamEvents connects to Radarr's event stream and returns a channel of typed events, like *QueueEvent.
+// Events that fail to parse are sent to config.OnError (if provided) and passed through as *starr.Event.
+// The channel is closed after the context is canceled. This requires a *starr.Config as the APIer.
+func (r *Radarr) StreamEvents(ctx context.Context, config *starr.StreamConfig) (<-chan interface{}, error) {
+	return starr.StreamParsed(ctx, r.APIer, config, ParseEvent) //nolint:wrapcheck
+}
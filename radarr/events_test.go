@@ -0,0 +1,75 @@
+package radarr_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/craigjmidwinter/starr"
+	"github.com/craigjmidwinter/starr/radarr"
+)
+
+func TestParseEvent(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		event    string
+		expected interface{}
+	}{
+		{
+			name:  "movie",
+			event: `{"name":"movie","body":{"action":"updated","resource":{"id":7,"title":"Movie","monitored":true}}}`,
+			expected: &radarr.MovieEvent{
+				Action: starr.EventActionUpdated,
+				Movie:  &radarr.Movie{ID: 7, Title: "Movie", Monitored: true},
+			},
+		},
+		{
+			name:  "movie file",
+			event: `{"name":"moviefile","action":"deleted","body":{"resource":{"id":3,"movieId":7}}}`,
+			expected: &radarr.MovieFileEvent{
+				Action:    starr.EventActionDeleted,
+				MovieFile: &radarr.MovieFile{ID: 3, MovieID: 7},
+			},
+		},
+		{
+			name:  "command",
+			event: `{"name":"command","body":{"action":"updated","resource":{"id":9,"name":"RefreshMovie"}}}`,
+			expected: &radarr.CommandEvent{
+				Action:  starr.EventActionUpdated,
+				Command: &radarr.CommandResponse{ID: 9, Name: "RefreshMovie"},
+			},
+		},
+		{
+			name:     "queue sync",
+			event:    `{"name":"queue","action":"sync","body":{"action":"sync"}}`,
+			expected: &radarr.QueueEvent{Action: starr.EventActionSync},
+		},
+		{
+			name:     "health",
+			event:    `{"name":"health","action":"sync"}`,
+			expected: &radarr.HealthEvent{Action: starr.EventActionSync},
+		},
+		{
+			name:     "unknown",
+			event:    `{"name":"calendar","action":"updated"}`,
+			expected: &starr.Event{Name: "calendar", Action: starr.EventActionUpdated},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			var event starr.Event
+			require.NoError(t, json.Unmarshal([]byte(test.event), &event))
+
+			output, err := radarr.ParseEvent(&event)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, output)
+		})
+	}
+}
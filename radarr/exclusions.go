@@ -20,6 +20,16 @@ type Exclusion struct {
 	ID     int64  `json:"id,omitempty"`
 }
 
+// Exclusions is the output from the /api/v3/exclusions/paged endpoint.
+type Exclusions struct {
+	Page          int          `json:"page"`
+	PageSize      int          `json:"pageSize"`
+	SortKey       string       `json:"sortKey"`
+	SortDirection string       `json:"sortDirection"`
+	TotalRecords  int          `json:"totalRecords"`
+	Records       []*Exclusion `json:"records"`
+}
+
 // GetExclusions returns all configured exclusions from Radarr.
 func (r *Radarr) GetExclusions() ([]*Exclusion, error) {
 	return r.GetExclusionsContext(context.Background())
@@ -37,6 +47,55 @@ func (r *Radarr) GetExclusionsContext(ctx context.Context) ([]*Exclusion, error)
 	return output, nil
 }
 
+// GetExclusionsPage returns a single page of exclusions from Radarr.
+// The page size and number is configurable with the input request parameters.
+func (r *Radarr) GetExclusionsPage(params *starr.PageReq) (*Exclusions, error) {
+	return r.GetExclusionsPageContext(context.Background(), params)
+}
+
+// GetExclusionsPageContext returns a single page of exclusions from Radarr.
+func (r *Radarr) GetExclusionsPageContext(ctx context.Context, params *starr.PageReq) (*Exclusions, error) {
+	var output Exclusions
+
+	params.CheckSet("sortKey", "id")
+
+	req := starr.Request{URI: path.Join(bpExclusions, "paged"), Query: params.Params()}
+	if err := r.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// GetExclusionByTmdbID finds an exclusion by its TMDb ID. The exclusions are searched a page
+// at a time so very large lists do not have to be held in memory. Returns nil if it's not found.
+func (r *Radarr) GetExclusionByTmdbID(tmdbID int64) (*Exclusion, error) {
+	return r.GetExclusionByTmdbIDContext(context.Background(), tmdbID)
+}
+
+// GetExclusionByTmdbIDContext finds an exclusion by its TMDb ID. Returns nil if it's not found.
+func (r *Radarr) GetExclusionByTmdbIDContext(ctx context.Context, tmdbID int64) (*Exclusion, error) {
+	var found *Exclusion
+
+	err := starr.Paginate(ctx, nil, 0, func(ctx context.Context, params *starr.PageReq) (int, int, error) {
+		curr, err := r.GetExclusionsPageContext(ctx, params)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		for _, exclusion := range curr.Records {
+			if exclusion.TMDBID == tmdbID {
+				found = exclusion
+				return 0, 0, starr.ErrStopPaging
+			}
+		}
+
+		return len(curr.Records), curr.TotalRecords, nil
+	})
+
+	return found, err
+}
+
 // DeleteExclusions removes exclusions from Radarr.
 func (r *Radarr) DeleteExclusions(ids []int64) error {
 	return r.DeleteExclusionsContext(context.Background(), ids)
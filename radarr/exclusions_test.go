@@ -0,0 +1,88 @@
+package radarr_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/craigjmidwinter/starr"
+	"github.com/craigjmidwinter/starr/radarr"
+)
+
+func TestGetExclusionsPage(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starr.TestMockData{
+		{
+			Name: "200",
+			ExpectedPath: path.Join("/", starr.API, radarr.APIver, "exclusions", "paged") +
+				"?page=1&pageSize=2&sortDirection=ascending&sortKey=id",
+			ResponseStatus: http.StatusOK,
+			ResponseBody: `{"page":1,"pageSize":2,"sortKey":"id","sortDirection":"ascending","totalRecords":5,` +
+				`"records":[{"id":1,"tmdbId":603,"movieTitle":"The Matrix","movieYear":1999}]}`,
+			WithRequest:    &starr.PageReq{PageSize: 2},
+			WithError:      nil,
+			ExpectedMethod: http.MethodGet,
+			WithResponse: &radarr.Exclusions{
+				Page:          1,
+				PageSize:      2,
+				SortKey:       "id",
+				SortDirection: "ascending",
+				TotalRecords:  5,
+				Records:       []*radarr.Exclusion{{ID: 1, TMDBID: 603, Title: "The Matrix", Year: 1999}},
+			},
+		},
+		{
+			Name: "404",
+			ExpectedPath: path.Join("/", starr.API, radarr.APIver, "exclusions", "paged") +
+				"?page=1&pageSize=10&sortDirection=ascending&sortKey=id",
+			ResponseStatus: http.StatusNotFound,
+			ResponseBody:   `{"message": "NotFound"}`,
+			WithRequest:    &starr.PageReq{},
+			WithError:      starr.ErrInvalidStatusCode,
+			ExpectedMethod: http.MethodGet,
+			WithResponse:   (*radarr.Exclusions)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := radarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.GetExclusionsPage(test.WithRequest.(*starr.PageReq))
+			assert.ErrorIs(t, err, test.WithError, "the wrong error was returned")
+			assert.EqualValues(t, test.WithResponse, output, "make sure ResponseBody and WithResponse are a match")
+		})
+	}
+}
+
+func TestGetExclusionByTmdbID(t *testing.T) {
+	t.Parallel()
+
+	pages := 0
+	// 2 records per page, with TMDb IDs 100 through 104.
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		pages++
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		first := int64(100 + (page-1)*2)
+
+		fmt.Fprintf(w, `{"page":%d,"totalRecords":5,"records":[{"id":%d,"tmdbId":%d},{"id":%d,"tmdbId":%d}]}`,
+			page, first, first, first+1, first+1)
+	}))
+	defer server.Close()
+
+	client := radarr.New(starr.New("mockAPIkey", server.URL, 0))
+
+	exclusion, err := client.GetExclusionByTmdbID(103)
+	require.NoError(t, err)
+	require.NotNil(t, exclusion)
+	assert.EqualValues(t, 103, exclusion.TMDBID)
+	assert.Equal(t, 2, pages, "paging must stop when the exclusion is found")
+}
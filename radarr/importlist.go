@@ -70,6 +70,55 @@ func (r *Radarr) GetImportListsContext(ctx context.Context) ([]*ImportList, erro
 	return output, nil
 }
 
+// GetImportListSchema returns the available import list types and their default settings.
+// Use one of these as a template for CreateImportList().
+func (r *Radarr) GetImportListSchema() ([]*ImportList, error) {
+	return r.GetImportListSchemaContext(context.Background())
+}
+
+// GetImportListSchemaContext returns the available import list types and their default settings.
+func (r *Radarr) GetImportListSchemaContext(ctx context.Context) ([]*ImportList, error) {
+	var output []*ImportList
+
+	req := starr.Request{URI: path.Join(bpImportList, "schema")}
+	if err := r.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// TestImportList asks Radarr to validate an import list's settings without saving it.
+// A nil error means the test passed.
+func (r *Radarr) TestImportList(list *ImportList) error {
+	return r.TestImportListContext(context.Background(), list)
+}
+
+// TestImportListContext asks Radarr to validate an import list's settings without saving it.
+func (r *Radarr) TestImportListContext(ctx context.Context, list *ImportList) error {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(list); err != nil {
+		return fmt.Errorf("json.Marshal(%s): %w", bpImportList, err)
+	}
+
+	req := starr.Request{URI: path.Join(bpImportList, "test"), Body: &body}
+	if err := r.PostAny(ctx, req); err != nil {
+		return fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+
+	return nil
+}
+
+// SyncImportLists sends the ImportListSync command, which fetches every enabled import list now.
+func (r *Radarr) SyncImportLists() (*CommandResponse, error) {
+	return r.SyncImportListsContext(context.Background())
+}
+
+// SyncImportListsContext sends the ImportListSync command, which fetches every enabled import list now.
+func (r *Radarr) SyncImportListsContext(ctx context.Context) (*CommandResponse, error) {
+	return r.SendCommandContext(ctx, &CommandRequest{Name: "ImportListSync"})
+}
+
 // CreateImportList creates an import list in Radarr.
 func (r *Radarr) CreateImportList(il *ImportList) (*ImportList, error) {
 	return r.CreateImportListContext(context.Background(), il)
@@ -0,0 +1,104 @@
+package radarr_test
+
+import (
+	"net/http"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/craigjmidwinter/starr"
+	"github.com/craigjmidwinter/starr/radarr"
+)
+
+func TestGetImportListSchema(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starr.TestMockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, radarr.APIver, "importlist", "schema"),
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusOK,
+			ResponseBody: `[{"id":0,"name":"","enabled":false,"listType":"tmdb",` +
+				`"implementation":"TMDbPopularImport","configContract":"TMDbPopularSettings"}]`,
+			WithError: nil,
+			WithResponse: []*radarr.ImportList{{
+				ListType:       "tmdb",
+				Implementation: "TMDbPopularImport",
+				ConfigContract: "TMDbPopularSettings",
+			}},
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, radarr.APIver, "importlist", "schema"),
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusNotFound,
+			ResponseBody:   `{"message": "NotFound"}`,
+			WithError:      starr.ErrInvalidStatusCode,
+			WithResponse:   []*radarr.ImportList(nil),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := radarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.GetImportListSchema()
+			assert.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
+
+func TestTestImportList(t *testing.T) {
+	t.Parallel()
+
+	list := &radarr.ImportList{
+		Name:           "Popular",
+		Enabled:        true,
+		ListType:       "tmdb",
+		Implementation: "TMDbPopularImport",
+		ConfigContract: "TMDbPopularSettings",
+	}
+
+	const listRequest = `{"id":0,"name":"Popular","enabled":true,"enableAuto":false,"shouldMonitor":false,` +
+		`"searchOnAdd":false,"rootFolderPath":"","qualityProfileId":0,"minimumAvailability":"",` +
+		`"listType":"tmdb","listOrder":0,"fields":null,"implementationName":"",` +
+		`"implementation":"TMDbPopularImport","configContract":"TMDbPopularSettings","infoLink":"","tags":null}`
+
+	tests := []*starr.TestMockData{
+		{
+			Name:            "200",
+			ExpectedPath:    path.Join("/", starr.API, radarr.APIver, "importlist", "test"),
+			ExpectedMethod:  http.MethodPost,
+			ResponseStatus:  http.StatusOK,
+			WithRequest:     list,
+			ExpectedRequest: listRequest + "\n",
+			ResponseBody:    "",
+			WithError:       nil,
+		},
+		{
+			Name:            "400",
+			ExpectedPath:    path.Join("/", starr.API, radarr.APIver, "importlist", "test"),
+			ExpectedMethod:  http.MethodPost,
+			ResponseStatus:  http.StatusBadRequest,
+			WithRequest:     list,
+			ExpectedRequest: listRequest + "\n",
+			ResponseBody:    `[{"propertyName": "ApiKey", "errorMessage": "Invalid API key"}]`,
+			WithError:       starr.ErrInvalidStatusCode,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := radarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			err := client.TestImportList(test.WithRequest.(*radarr.ImportList))
+			assert.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package radarr
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/craigjmidwinter/starr"
+)
+
+const bpManualImport = APIver + "/manualimport"
+
+// ManualImportInput is a file to import with the ManualImport command.
+// Build these from the output of GetManualImport, after choosing the correct movie.
+type ManualImportInput struct {
+	Path         string         `json:"path"`
+	FolderName   string         `json:"folderName,omitempty"`
+	MovieID      int64          `json:"movieId"`
+	Quality      *starr.Quality `json:"quality,omitempty"`
+	Languages    []*starr.Value `json:"languages,omitempty"`
+	ReleaseGroup string         `json:"releaseGroup,omitempty"`
+	DownloadID   string         `json:"downloadId,omitempty"`
+	IndexerFlags int64          `json:"indexerFlags,omitempty"`
+}
+
+// ManualImportOutput is a candidate file returned from the /api/v3/manualimport endpoint.
+// Movie is nil when Radarr could not identify the file; Rejections explain why it will not import on its own.
+type ManualImportOutput struct {
+	ID                int64           `json:"id"`
+	Path              string          `json:"path"`
+	RelativePath      string          `json:"relativePath"`
+	FolderName        string          `json:"folderName"`
+	Name              string          `json:"name"`
+	Size              int64           `json:"size"`
+	Movie             *Movie          `json:"movie"`
+	Quality           *starr.Quality  `json:"quality"`
+	Languages         []*starr.Value  `json:"languages"`
+	ReleaseGroup      string          `json:"releaseGroup"`
+	QualityWeight     int64           `json:"qualityWeight"`
+	DownloadID        string          `json:"downloadId"`
+	CustomFormats     []*CustomFormat `json:"customFormats"`
+	CustomFormatScore int64           `json:"customFormatScore"`
+	IndexerFlags      int64           `json:"indexerFlags"`
+	Rejections        []*Rejection    `json:"rejections"`
+}
+
+// Rejection is a reason a file will not be imported.
+type Rejection struct {
+	Reason string `json:"reason"`
+	Type   string `json:"type"` // permanent or temporary
+}
+
+// GetManualImport scans a folder, or the output path of a download client item, and returns
+// the files that may be imported. Provide a folder or a downloadID. When movieID is not zero,
+// files are matched to that movie instead of parsing it from the file name.
+// Send the files to the ManualImport command with SendCommand() to import them.
+func (r *Radarr) GetManualImport(
+	folder, downloadID string,
+	movieID int64,
+	filterExistingFiles bool,
+) ([]*ManualImportOutput, error) {
+	return r.GetManualImportContext(context.Background(), folder, downloadID, movieID, filterExistingFiles)
+}
+
+// GetManualImportContext scans a folder, or the output path of a download client item, and returns
+// the files that may be imported.
+func (r *Radarr) GetManualImportContext(
+	ctx context.Context,
+	folder, downloadID string,
+	movieID int64,
+	filterExistingFiles bool,
+) ([]*ManualImportOutput, error) {
+	var output []*ManualImportOutput
+
+	req := starr.Request{URI: bpManualImport, Query: make(url.Values)}
+	req.Query.Add("filterExistingFiles", fmt.Sprint(filterExistingFiles))
+
+	if folder != "" {
+		req.Query.Add("folder", folder)
+	}
+
+	if downloadID != "" {
+		req.Query.Add("downloadId", downloadID)
+	}
+
+	if movieID != 0 {
+		req.Query.Add("movieId", fmt.Sprint(movieID))
+	}
+
+	if err := r.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// ManualImportInput returns the input needed to import this file with the ManualImport command.
+// The file must be matched to a movie; set Movie before calling this if Radarr did not identify it.
+func (m *ManualImportOutput) ManualImportInput() *ManualImportInput {
+	input := &ManualImportInput{
+		Path:         m.Path,
+		FolderName:   m.FolderName,
+		Quality:      m.Quality,
+		Languages:    m.Languages,
+		ReleaseGroup: m.ReleaseGroup,
+		DownloadID:   m.DownloadID,
+		IndexerFlags: m.IndexerFlags,
+	}
+
+	if m.Movie != nil {
+		input.MovieID = m.Movie.ID
+	}
+
+	return input
+}
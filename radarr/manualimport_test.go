@@ -0,0 +1,111 @@
+package radarr_test
+
+import (
+	"net/http"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/craigjmidwinter/starr"
+	"github.com/craigjmidwinter/starr/radarr"
+)
+
+const testManualImportJSON = `{
+	"id": 1,
+	"path": "/downloads/Some.Film.2021.1080p/film.mkv",
+	"relativePath": "film.mkv",
+	"folderName": "Some.Film.2021.1080p",
+	"name": "film",
+	"size": 1000,
+	"quality": {"quality": {"id": 7, "name": "Bluray-1080p"}},
+	"languages": [{"id": 1, "name": "English"}],
+	"releaseGroup": "GRP",
+	"downloadId": "ABCD",
+	"rejections": [{"reason": "Unable to identify movie", "type": "permanent"}]
+}`
+
+func TestGetManualImport(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starr.TestMockData{
+		{
+			Name: "200",
+			ExpectedPath: path.Join("/", starr.API, radarr.APIver, "manualimport") +
+				"?downloadId=ABCD&filterExistingFiles=true",
+			ResponseStatus: http.StatusOK,
+			ResponseBody:   `[` + testManualImportJSON + `]`,
+			WithRequest:    []interface{}{"", "ABCD", int64(0), true},
+			WithError:      nil,
+			ExpectedMethod: http.MethodGet,
+			WithResponse: []*radarr.ManualImportOutput{{
+				ID:           1,
+				Path:         "/downloads/Some.Film.2021.1080p/film.mkv",
+				RelativePath: "film.mkv",
+				FolderName:   "Some.Film.2021.1080p",
+				Name:         "film",
+				Size:         1000,
+				Quality:      &starr.Quality{Quality: &starr.BaseQuality{ID: 7, Name: "Bluray-1080p"}},
+				Languages:    []*starr.Value{{ID: 1, Name: "English"}},
+				ReleaseGroup: "GRP",
+				DownloadID:   "ABCD",
+				Rejections:   []*radarr.Rejection{{Reason: "Unable to identify movie", Type: "permanent"}},
+			}},
+		},
+		{
+			Name: "404",
+			ExpectedPath: path.Join("/", starr.API, radarr.APIver, "manualimport") +
+				"?filterExistingFiles=false&folder=%2Fdownloads&movieId=17",
+			ResponseStatus: http.StatusNotFound,
+			ResponseBody:   `{"message": "NotFound"}`,
+			WithRequest:    []interface{}{"/downloads", "", int64(17), false},
+			WithError:      starr.ErrInvalidStatusCode,
+			ExpectedMethod: http.MethodGet,
+			WithResponse:   []*radarr.ManualImportOutput(nil),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := radarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			input := test.WithRequest.([]interface{})
+			output, err := client.GetManualImport(input[0].(string), input[1].(string), input[2].(int64), input[3].(bool))
+			assert.ErrorIs(t, err, test.WithError, "the wrong error was returned")
+			assert.EqualValues(t, test.WithResponse, output, "make sure ResponseBody and WithResponse are a match")
+		})
+	}
+}
+
+func TestManualImportCommand(t *testing.T) {
+	t.Parallel()
+
+	file := &radarr.ManualImportOutput{
+		Path:       "/downloads/film.mkv",
+		Movie:      &radarr.Movie{ID: 17},
+		Quality:    &starr.Quality{Quality: &starr.BaseQuality{ID: 7}},
+		DownloadID: "ABCD",
+	}
+
+	test := &starr.TestMockData{
+		Name:           "200",
+		ExpectedPath:   path.Join("/", starr.API, radarr.APIver, "command"),
+		ResponseStatus: http.StatusCreated,
+		ResponseBody:   `{"id":99,"name":"ManualImport","status":"queued"}`,
+		ExpectedRequest: `{"name":"ManualImport","importMode":"move","files":[{"path":"/downloads/film.mkv","movieId":17,` +
+			`"quality":{"quality":{"id":7,"name":""},"allowed":false},"downloadId":"ABCD"}]}` + "\n",
+		ExpectedMethod: http.MethodPost,
+		WithResponse:   &radarr.CommandResponse{ID: 99, Name: "ManualImport", Status: "queued"},
+	}
+
+	mockServer := test.GetMockServer(t)
+	client := radarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+	output, err := client.SendCommand(&radarr.CommandRequest{
+		Name:         "ManualImport",
+		ManualImport: []*radarr.ManualImportInput{file.ManualImportInput()},
+		ImportMode:   starr.ImportModeMove,
+	})
+	assert.NoError(t, err)
+	assert.EqualValues(t, test.WithResponse, output)
+}
@@ -0,0 +1,197 @@
+package radarr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"path"
+	"time"
+
+	"github.com/craigjmidwinter/starr"
+)
+
+const bpMovieFile = APIver + "/moviefile"
+
+// MovieFile is part of a Movie, and the output from the /api/v3/moviefile endpoint.
+type MovieFile struct {
+	ID                  int64          `json:"id"`
+	MovieID             int64          `json:"movieId"`
+	RelativePath        string         `json:"relativePath"`
+	Path                string         `json:"path"`
+	Size                int64          `json:"size"`
+	DateAdded           time.Time      `json:"dateAdded"`
+	SceneName           string         `json:"sceneName"`
+	IndexerFlags        int64          `json:"indexerFlags"`
+	Quality             *starr.Quality `json:"quality"`
+	MediaInfo           *MediaInfo     `json:"mediaInfo"`
+	QualityCutoffNotMet bool           `json:"qualityCutoffNotMet"`
+	Languages           []*starr.Value `json:"languages"`
+	ReleaseGroup        string         `json:"releaseGroup"`
+	Edition             string         `json:"edition"`
+	OriginalFilePath    string         `json:"originalFilePath"`
+}
+
+// MediaInfo is part of a MovieFile.
+type MediaInfo struct {
+	AudioAdditionalFeatures string  `json:"audioAdditionalFeatures"`
+	AudioBitrate            int     `json:"audioBitrate"`
+	AudioChannels           float64 `json:"audioChannels"`
+	AudioCodec              string  `json:"audioCodec"`
+	AudioLanguages          string  `json:"audioLanguages"`
+	AudioStreamCount        int     `json:"audioStreamCount"`
+	VideoBitDepth           int     `json:"videoBitDepth"`
+	VideoBitrate            int     `json:"videoBitrate"`
+	VideoCodec              string  `json:"videoCodec"`
+	VideoFps                float64 `json:"videoFps"`
+	VideoDynamicRangeType   string  `json:"videoDynamicRangeType"`
+	Resolution              string  `json:"resolution"`
+	RunTime                 string  `json:"runTime"`
+	ScanType                string  `json:"scanType"`
+	Subtitles               string  `json:"subtitles"`
+}
+
+// MovieFileEditor is the input for the bulk movie file editor endpoint.
+// Only the provided (non-nil) members are changed on every file in MovieFileIDs.
+// You may use starr.String() and starr.Int64() to add data to the pointer members.
+type MovieFileEditor struct {
+	MovieFileIDs []int64        `json:"movieFileIds"`
+	Languages    []*starr.Value `json:"languages,omitempty"`
+	Quality      *starr.Quality `json:"quality,omitempty"`
+	Edition      *string        `json:"edition,omitempty"`
+	ReleaseGroup *string        `json:"releaseGroup,omitempty"`
+	SceneName    *string        `json:"sceneName,omitempty"`
+	IndexerFlags *int64         `json:"indexerFlags,omitempty"`
+}
+
+// GetMovieFiles returns the requested movie files by their IDs.
+func (r *Radarr) GetMovieFiles(movieFileIDs []int64) ([]*MovieFile, error) {
+	return r.GetMovieFilesContext(context.Background(), movieFileIDs)
+}
+
+// GetMovieFilesContext returns the requested movie files by their IDs.
+func (r *Radarr) GetMovieFilesContext(ctx context.Context, movieFileIDs []int64) ([]*MovieFile, error) {
+	var output []*MovieFile
+
+	if len(movieFileIDs) == 0 {
+		return output, nil
+	}
+
+	req := starr.Request{
+		URI:   bpMovieFile,
+		Query: url.Values{"movieFileIds": make([]string, len(movieFileIDs))},
+	}
+
+	for idx, fileID := range movieFileIDs {
+		req.Query["movieFileIds"][idx] = fmt.Sprint(fileID)
+	}
+
+	if err := r.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// GetMovieFilesForMovie returns the movie files for a movie.
+func (r *Radarr) GetMovieFilesForMovie(movieID int64) ([]*MovieFile, error) {
+	return r.GetMovieFilesForMovieContext(context.Background(), movieID)
+}
+
+// GetMovieFilesForMovieContext returns the movie files for a movie.
+func (r *Radarr) GetMovieFilesForMovieContext(ctx context.Context, movieID int64) ([]*MovieFile, error) {
+	var output []*MovieFile
+
+	req := starr.Request{URI: bpMovieFile, Query: make(url.Values)}
+	req.Query.Add("movieId", fmt.Sprint(movieID))
+
+	if err := r.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// UpdateMovieFile updates a movie file; usually its quality or languages.
+func (r *Radarr) UpdateMovieFile(movieFile *MovieFile) (*MovieFile, error) {
+	return r.UpdateMovieFileContext(context.Background(), movieFile)
+}
+
+// UpdateMovieFileContext updates a movie file; usually its quality or languages.
+func (r *Radarr) UpdateMovieFileContext(ctx context.Context, movieFile *MovieFile) (*MovieFile, error) {
+	var output MovieFile
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(movieFile); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpMovieFile, err)
+	}
+
+	req := starr.Request{URI: path.Join(bpMovieFile, fmt.Sprint(movieFile.ID)), Body: &body}
+	if err := r.PutInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Put(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// EditMovieFiles updates many movie files at once with the bulk editor.
+func (r *Radarr) EditMovieFiles(editor *MovieFileEditor) ([]*MovieFile, error) {
+	return r.EditMovieFilesContext(context.Background(), editor)
+}
+
+// EditMovieFilesContext updates many movie files at once with the bulk editor.
+func (r *Radarr) EditMovieFilesContext(ctx context.Context, editor *MovieFileEditor) ([]*MovieFile, error) {
+	var output []*MovieFile
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(editor); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpMovieFile, err)
+	}
+
+	req := starr.Request{URI: path.Join(bpMovieFile, "editor"), Body: &body}
+	if err := r.PutInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Put(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// DeleteMovieFile deletes a movie file.
+func (r *Radarr) DeleteMovieFile(movieFileID int64) error {
+	return r.DeleteMovieFileContext(context.Background(), movieFileID)
+}
+
+// DeleteMovieFileContext deletes a movie file.
+func (r *Radarr) DeleteMovieFileContext(ctx context.Context, movieFileID int64) error {
+	req := starr.Request{URI: path.Join(bpMovieFile, fmt.Sprint(movieFileID))}
+	if err := r.DeleteAny(ctx, req); err != nil {
+		return fmt.Errorf("api.Delete(%s): %w", &req, err)
+	}
+
+	return nil
+}
+
+// DeleteMovieFiles bulk deletes movie files by their IDs.
+func (r *Radarr) DeleteMovieFiles(movieFileIDs []int64) error {
+	return r.DeleteMovieFilesContext(context.Background(), movieFileIDs)
+}
+
+// DeleteMovieFilesContext bulk deletes movie files by their IDs.
+func (r *Radarr) DeleteMovieFilesContext(ctx context.Context, movieFileIDs []int64) error {
+	postData := struct {
+		T []int64 `json:"movieFileIds"`
+	}{movieFileIDs}
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(&postData); err != nil {
+		return fmt.Errorf("json.Marshal(%s): %w", bpMovieFile, err)
+	}
+
+	req := starr.Request{URI: path.Join(bpMovieFile, "bulk"), Body: &body}
+	if err := r.DeleteAny(ctx, req); err != nil {
+		return fmt.Errorf("api.Delete(%s): %w", &req, err)
+	}
+
+	return nil
+}
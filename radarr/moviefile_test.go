@@ -0,0 +1,124 @@
+package radarr_test
+
+import (
+	"net/http"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/craigjmidwinter/starr"
+	"github.com/craigjmidwinter/starr/radarr"
+)
+
+func TestGetMovieFiles(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starr.TestMockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, radarr.APIver, "moviefile") + "?movieFileIds=2&movieFileIds=5",
+			ResponseStatus: http.StatusOK,
+			ResponseBody:   `[{"id":2,"movieId":1,"size":100},{"id":5,"movieId":1,"edition":"IMAX"}]`,
+			WithRequest:    []int64{2, 5},
+			WithError:      nil,
+			ExpectedMethod: http.MethodGet,
+			WithResponse: []*radarr.MovieFile{
+				{ID: 2, MovieID: 1, Size: 100},
+				{ID: 5, MovieID: 1, Edition: "IMAX"},
+			},
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, radarr.APIver, "moviefile") + "?movieFileIds=2",
+			ResponseStatus: http.StatusNotFound,
+			ResponseBody:   `{"message": "NotFound"}`,
+			WithRequest:    []int64{2},
+			WithError:      starr.ErrInvalidStatusCode,
+			ExpectedMethod: http.MethodGet,
+			WithResponse:   []*radarr.MovieFile(nil),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := radarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.GetMovieFiles(test.WithRequest.([]int64))
+			assert.ErrorIs(t, err, test.WithError, "the wrong error was returned")
+			assert.EqualValues(t, test.WithResponse, output, "make sure ResponseBody and WithResponse are a match")
+		})
+	}
+}
+
+func TestEditMovieFiles(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starr.TestMockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, radarr.APIver, "moviefile", "editor"),
+			ResponseStatus: http.StatusOK,
+			ResponseBody:   `[{"id":2,"releaseGroup":"GRP"},{"id":5,"releaseGroup":"GRP"}]`,
+			WithRequest: &radarr.MovieFileEditor{
+				MovieFileIDs: []int64{2, 5},
+				Languages:    []*starr.Value{{ID: 1, Name: "English"}},
+				ReleaseGroup: starr.String("GRP"),
+			},
+			ExpectedRequest: `{"movieFileIds":[2,5],"languages":[{"id":1,"name":"English"}],"releaseGroup":"GRP"}` + "\n",
+			WithError:       nil,
+			ExpectedMethod:  http.MethodPut,
+			WithResponse:    []*radarr.MovieFile{{ID: 2, ReleaseGroup: "GRP"}, {ID: 5, ReleaseGroup: "GRP"}},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := radarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.EditMovieFiles(test.WithRequest.(*radarr.MovieFileEditor))
+			assert.ErrorIs(t, err, test.WithError, "the wrong error was returned")
+			assert.EqualValues(t, test.WithResponse, output, "make sure ResponseBody and WithResponse are a match")
+		})
+	}
+}
+
+func TestDeleteMovieFiles(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starr.TestMockData{
+		{
+			Name:            "200",
+			ExpectedPath:    path.Join("/", starr.API, radarr.APIver, "moviefile", "bulk"),
+			ResponseStatus:  http.StatusOK,
+			WithRequest:     []int64{2, 5},
+			ExpectedRequest: `{"movieFileIds":[2,5]}` + "\n",
+			WithError:       nil,
+			ExpectedMethod:  http.MethodDelete,
+		},
+		{
+			Name:            "404",
+			ExpectedPath:    path.Join("/", starr.API, radarr.APIver, "moviefile", "bulk"),
+			ResponseStatus:  http.StatusNotFound,
+			ResponseBody:    `{"message": "NotFound"}`,
+			WithRequest:     []int64{2},
+			ExpectedRequest: `{"movieFileIds":[2]}` + "\n",
+			WithError:       starr.ErrInvalidStatusCode,
+			ExpectedMethod:  http.MethodDelete,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := radarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			err := client.DeleteMovieFiles(test.WithRequest.([]int64))
+			assert.ErrorIs(t, err, test.WithError, "the wrong error was returned")
+		})
+	}
+}
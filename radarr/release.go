@@ -0,0 +1,145 @@
+package radarr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"path"
+	"time"
+
+	"github.com/craigjmidwinter/starr"
+)
+
+const bpRelease = APIver + "/release"
+
+// Release is the output from the /api/v3/release endpoint.
+// These are the releases found when searching indexers for a movie (interactive search).
+type Release struct {
+	ID                  int64           `json:"id,omitempty"`
+	GUID                string          `json:"guid"`
+	Quality             *starr.Quality  `json:"quality"`
+	CustomFormats       []*CustomFormat `json:"customFormats,omitempty"`
+	CustomFormatScore   int64           `json:"customFormatScore"`
+	QualityWeight       int64           `json:"qualityWeight"`
+	Age                 int64           `json:"age"`
+	AgeHours            float64         `json:"ageHours"`
+	AgeMinutes          float64         `json:"ageMinutes"`
+	Size                int64           `json:"size"`
+	IndexerID           int64           `json:"indexerId"`
+	Indexer             string          `json:"indexer"`
+	ReleaseGroup        string          `json:"releaseGroup,omitempty"`
+	SubGroup            string          `json:"subGroup,omitempty"`
+	ReleaseHash         string          `json:"releaseHash,omitempty"`
+	Title               string          `json:"title"`
+	SceneSource         bool            `json:"sceneSource"`
+	MovieTitles         []string        `json:"movieTitles,omitempty"`
+	Languages           []*starr.Value  `json:"languages,omitempty"`
+	MappedMovieID       int64           `json:"mappedMovieId,omitempty"`
+	Approved            bool            `json:"approved"`
+	TemporarilyRejected bool            `json:"temporarilyRejected"`
+	Rejected            bool            `json:"rejected"`
+	TmdbID              int64           `json:"tmdbId,omitempty"`
+	ImdbID              string          `json:"imdbId,omitempty"`
+	Rejections          []string        `json:"rejections,omitempty"`
+	PublishDate         time.Time       `json:"publishDate"`
+	CommentURL          string          `json:"commentUrl,omitempty"`
+	DownloadURL         string          `json:"downloadUrl,omitempty"`
+	InfoURL             string          `json:"infoUrl,omitempty"`
+	DownloadAllowed     bool            `json:"downloadAllowed"`
+	ReleaseWeight       int64           `json:"releaseWeight"`
+	Edition             string          `json:"edition,omitempty"`
+	MagnetURL           string          `json:"magnetUrl,omitempty"`
+	InfoHash            string          `json:"infoHash,omitempty"`
+	Seeders             int64           `json:"seeders,omitempty"`
+	Leechers            int64           `json:"leechers,omitempty"`
+	Protocol            string          `json:"protocol"`
+	IndexerFlags        []string        `json:"indexerFlags,omitempty"`
+	MovieID             int64           `json:"movieId,omitempty"`
+}
+
+// PushRelease is the input for pushing a release to Radarr with PushRelease().
+// Radarr parses the title, and if it matches a wanted movie, sends it to a download client.
+type PushRelease struct {
+	Title            string    `json:"title"`
+	DownloadURL      string    `json:"downloadUrl,omitempty"`
+	MagnetURL        string    `json:"magnetUrl,omitempty"`
+	Protocol         string    `json:"protocol"`
+	PublishDate      time.Time `json:"publishDate"`
+	Indexer          string    `json:"indexer,omitempty"`
+	IndexerID        int64     `json:"indexerId,omitempty"`
+	Size             int64     `json:"size,omitempty"`
+	DownloadClientID int64     `json:"downloadClientId,omitempty"`
+	DownloadClient   string    `json:"downloadClient,omitempty"`
+}
+
+// SearchRelease searches all enabled indexers for a movie and returns every release found.
+// Releases are not grabbed; use GrabRelease to download one. This may take a while.
+func (r *Radarr) SearchRelease(movieID int64) ([]*Release, error) {
+	return r.SearchReleaseContext(context.Background(), movieID)
+}
+
+// SearchReleaseContext searches all enabled indexers for a movie and returns every release found.
+func (r *Radarr) SearchReleaseContext(ctx context.Context, movieID int64) ([]*Release, error) {
+	var output []*Release
+
+	req := starr.Request{URI: bpRelease, Query: make(url.Values)}
+	req.Query.Add("movieId", fmt.Sprint(movieID))
+
+	if err := r.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// GrabRelease sends a release found with SearchRelease() to a download client.
+// Only the GUID and IndexerID are required, and the release must have been
+// returned by a recent search, because Radarr looks it up in its release cache.
+func (r *Radarr) GrabRelease(release *Release) (*Release, error) {
+	return r.GrabReleaseContext(context.Background(), release)
+}
+
+// GrabReleaseContext sends a release found with SearchRelease() to a download client.
+func (r *Radarr) GrabReleaseContext(ctx context.Context, release *Release) (*Release, error) {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(&struct {
+		GUID      string `json:"guid"`
+		IndexerID int64  `json:"indexerId"`
+	}{GUID: release.GUID, IndexerID: release.IndexerID}); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpRelease, err)
+	}
+
+	var output Release
+
+	req := starr.Request{URI: bpRelease, Body: &body}
+	if err := r.PostInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// PushRelease sends a release from an outside source to Radarr.
+// The returned releases show if it was approved, and why it was rejected if it was not.
+func (r *Radarr) PushRelease(release *PushRelease) ([]*Release, error) {
+	return r.PushReleaseContext(context.Background(), release)
+}
+
+// PushReleaseContext sends a release from an outside source to Radarr.
+func (r *Radarr) PushReleaseContext(ctx context.Context, release *PushRelease) ([]*Release, error) {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(release); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpRelease, err)
+	}
+
+	var output []*Release
+
+	req := starr.Request{URI: path.Join(bpRelease, "push"), Body: &body}
+	if err := r.PostInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,70 @@
+package radarr
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/craigjmidwinter/starr"
+)
+
+const bpRename = APIver + "/rename"
+
+// Rename is a file that will be renamed with the current naming format.
+// The paths are relative to the movie folder.
+type Rename struct {
+	MovieID      int64  `json:"movieId"`
+	MovieFileID  int64  `json:"movieFileId"`
+	ExistingPath string `json:"existingPath"`
+	NewPath      string `json:"newPath"`
+}
+
+// GetRenamePreview returns the files for a movie that do not match the current naming format.
+// Nothing is renamed; send the file IDs to RenameFiles() to apply the changes.
+func (r *Radarr) GetRenamePreview(movieID int64) ([]*Rename, error) {
+	return r.GetRenamePreviewContext(context.Background(), movieID)
+}
+
+// GetRenamePreviewContext returns the files for a movie that do not match the current naming format.
+func (r *Radarr) GetRenamePreviewContext(ctx context.Context, movieID int64) ([]*Rename, error) {
+	var output []*Rename
+
+	req := starr.Request{URI: bpRename, Query: make(url.Values)}
+	req.Query.Add("movieId", fmt.Sprint(movieID))
+
+	if err := r.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// RenameFiles sends a RenameFiles command to rename the provided files for a single movie.
+// Get the file IDs from GetRenamePreview().
+func (r *Radarr) RenameFiles(movieID int64, movieFileIDs []int64) (*CommandResponse, error) {
+	return r.RenameFilesContext(context.Background(), movieID, movieFileIDs)
+}
+
+// RenameFilesContext sends a RenameFiles command to rename the provided files for a single movie.
+func (r *Radarr) RenameFilesContext(ctx context.Context, movieID int64, movieFileIDs []int64) (*CommandResponse, error) {
+	return r.SendCommandContext(ctx, &CommandRequest{Name: "RenameFiles", Files: movieFileIDs, MovieID: movieID})
+}
+
+// RenameMovies sends RenameMovie commands for the provided movies in batches of batchSize,
+// waiting for delay between each batch. Every file for each movie is renamed to match the naming format.
+// A batchSize of zero sends every movie in a single command. The responses for every command sent are returned.
+func (r *Radarr) RenameMovies(movieIDs []int64, batchSize int, delay time.Duration) ([]*CommandResponse, error) {
+	return r.RenameMoviesContext(context.Background(), movieIDs, batchSize, delay)
+}
+
+// RenameMoviesContext sends RenameMovie commands for the provided movies in batches of batchSize,
+// waiting for delay between each batch. The wait ends early if the context is canceled.
+func (r *Radarr) RenameMoviesContext(
+	ctx context.Context,
+	movieIDs []int64,
+	batchSize int,
+	delay time.Duration,
+) ([]*CommandResponse, error) {
+	return r.sendBatches(ctx, "RenameMovie", movieIDs, batchSize, delay)
+}
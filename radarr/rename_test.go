@@ -0,0 +1,73 @@
+package radarr_test
+
+import (
+	"net/http"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/craigjmidwinter/starr"
+	"github.com/craigjmidwinter/starr/radarr"
+)
+
+func TestGetRenamePreview(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starr.TestMockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, radarr.APIver, "rename") + "?movieId=17",
+			ResponseStatus: http.StatusOK,
+			ResponseBody: `[{"movieId":17,"movieFileId":5,"existingPath":"film.mkv",` +
+				`"newPath":"Some Film (2021) Bluray-1080p.mkv"}]`,
+			WithRequest:    int64(17),
+			WithError:      nil,
+			ExpectedMethod: http.MethodGet,
+			WithResponse: []*radarr.Rename{
+				{MovieID: 17, MovieFileID: 5, ExistingPath: "film.mkv", NewPath: "Some Film (2021) Bluray-1080p.mkv"},
+			},
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, radarr.APIver, "rename") + "?movieId=17",
+			ResponseStatus: http.StatusNotFound,
+			ResponseBody:   `{"message": "NotFound"}`,
+			WithRequest:    int64(17),
+			WithError:      starr.ErrInvalidStatusCode,
+			ExpectedMethod: http.MethodGet,
+			WithResponse:   []*radarr.Rename(nil),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := radarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.GetRenamePreview(test.WithRequest.(int64))
+			assert.ErrorIs(t, err, test.WithError, "the wrong error was returned")
+			assert.EqualValues(t, test.WithResponse, output, "make sure ResponseBody and WithResponse are a match")
+		})
+	}
+}
+
+func TestRenameFiles(t *testing.T) {
+	t.Parallel()
+
+	test := &starr.TestMockData{
+		Name:            "200",
+		ExpectedPath:    path.Join("/", starr.API, radarr.APIver, "command"),
+		ResponseStatus:  http.StatusCreated,
+		ResponseBody:    `{"id":12,"name":"RenameFiles","status":"queued"}`,
+		ExpectedRequest: `{"name":"RenameFiles","files":[5,6],"movieId":17}` + "\n",
+		ExpectedMethod:  http.MethodPost,
+		WithResponse:    &radarr.CommandResponse{ID: 12, Name: "RenameFiles", Status: "queued"},
+	}
+
+	mockServer := test.GetMockServer(t)
+	client := radarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+	output, err := client.RenameFiles(17, []int64{5, 6})
+	assert.NoError(t, err)
+	assert.EqualValues(t, test.WithResponse, output)
+}
@@ -0,0 +1,143 @@
+package radarr
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"time"
+
+	"github.com/craigjmidwinter/starr"
+)
+
+const bpWanted = APIver + "/wanted"
+
+// Wanted is the output from the /api/v3/wanted/missing and /api/v3/wanted/cutoff endpoints.
+type Wanted struct {
+	Page          int      `json:"page"`
+	PageSize      int      `json:"pageSize"`
+	SortKey       string   `json:"sortKey"`
+	SortDirection string   `json:"sortDirection"`
+	TotalRecords  int      `json:"totalRecords"`
+	Records       []*Movie `json:"records"`
+}
+
+// MovieIDs returns the IDs for every movie in the wanted list. Useful with SearchMovies().
+func (w *Wanted) MovieIDs() []int64 {
+	ids := make([]int64, len(w.Records))
+	for idx, movie := range w.Records {
+		ids[idx] = movie.ID
+	}
+
+	return ids
+}
+
+// GetWantedMissing returns monitored movies that do not have a file.
+// This function simply returns the number of records desired, up to the number of records present in
+// the application. It grabs records in (paginated) batches of perPage, and concatenates them into one list.
+// Passing zero for records will return all of them.
+func (r *Radarr) GetWantedMissing(records, perPage int) (*Wanted, error) {
+	return r.GetWantedMissingContext(context.Background(), records, perPage)
+}
+
+// GetWantedMissingContext returns monitored movies that do not have a file.
+func (r *Radarr) GetWantedMissingContext(ctx context.Context, records, perPage int) (*Wanted, error) {
+	return r.getWanted(ctx, "missing", records, perPage)
+}
+
+// GetWantedMissingPage returns a single page of monitored movies that do not have a file.
+// The page size and number is configurable with the input request parameters.
+// Set the "monitored" parameter to "false" to list unmonitored movies instead.
+func (r *Radarr) GetWantedMissingPage(params *starr.PageReq) (*Wanted, error) {
+	return r.GetWantedMissingPageContext(context.Background(), params)
+}
+
+// GetWantedMissingPageContext returns a single page of monitored movies that do not have a file.
+func (r *Radarr) GetWantedMissingPageContext(ctx context.Context, params *starr.PageReq) (*Wanted, error) {
+	return r.getWantedPage(ctx, "missing", params)
+}
+
+// GetWantedCutoff returns monitored movies with a file that has not met the quality profile cutoff.
+// This function simply returns the number of records desired, up to the number of records present in
+// the application. It grabs records in (paginated) batches of perPage, and concatenates them into one list.
+// Passing zero for records will return all of them.
+func (r *Radarr) GetWantedCutoff(records, perPage int) (*Wanted, error) {
+	return r.GetWantedCutoffContext(context.Background(), records, perPage)
+}
+
+// GetWantedCutoffContext returns monitored movies with a file that has not met the quality profile cutoff.
+func (r *Radarr) GetWantedCutoffContext(ctx context.Context, records, perPage int) (*Wanted, error) {
+	return r.getWanted(ctx, "cutoff", records, perPage)
+}
+
+// GetWantedCutoffPage returns a single page of movies that have not met the quality profile cutoff.
+// The page size and number is configurable with the input request parameters.
+func (r *Radarr) GetWantedCutoffPage(params *starr.PageReq) (*Wanted, error) {
+	return r.GetWantedCutoffPageContext(context.Background(), params)
+}
+
+// GetWantedCutoffPageContext returns a single page of movies that have not met the quality profile cutoff.
+func (r *Radarr) GetWantedCutoffPageContext(ctx context.Context, params *starr.PageReq) (*Wanted, error) {
+	return r.getWantedPage(ctx, "cutoff", params)
+}
+
+func (r *Radarr) getWanted(ctx context.Context, list string, records, perPage int) (*Wanted, error) {
+	wanted := &Wanted{Records: []*Movie{}}
+
+	err := starr.Paginate(ctx, &starr.PageReq{PageSize: perPage}, records,
+		func(ctx context.Context, params *starr.PageReq) (int, int, error) {
+			curr, err := r.getWantedPage(ctx, list, params)
+			if err != nil {
+				return 0, 0, err
+			}
+
+			wanted.Records = append(wanted.Records, curr.Records...)
+			wanted.PageSize = curr.TotalRecords
+			wanted.TotalRecords = curr.TotalRecords
+			wanted.SortDirection = curr.SortDirection
+			wanted.SortKey = curr.SortKey
+
+			return len(curr.Records), curr.TotalRecords, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	// The last page may return more records than requested.
+	if records > 0 && len(wanted.Records) > records {
+		wanted.Records = wanted.Records[:records]
+	}
+
+	return wanted, nil
+}
+
+func (r *Radarr) getWantedPage(ctx context.Context, list string, params *starr.PageReq) (*Wanted, error) {
+	var output Wanted
+
+	params.CheckSet("sortKey", "movieMetadata.sortTitle")
+	params.CheckSet("monitored", "true")
+
+	req := starr.Request{URI: path.Join(bpWanted, list), Query: params.Params()}
+	if err := r.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// SearchMovies sends MoviesSearch commands for the provided movies in batches of batchSize,
+// waiting for delay between each batch. This spreads large searches out so indexers are not flooded.
+// A batchSize of zero sends every movie in a single command. The responses for every command sent are returned.
+func (r *Radarr) SearchMovies(movieIDs []int64, batchSize int, delay time.Duration) ([]*CommandResponse, error) {
+	return r.SearchMoviesContext(context.Background(), movieIDs, batchSize, delay)
+}
+
+// SearchMoviesContext sends MoviesSearch commands for the provided movies in batches of batchSize,
+// waiting for delay between each batch. The wait ends early if the context is canceled.
+func (r *Radarr) SearchMoviesContext(
+	ctx context.Context,
+	movieIDs []int64,
+	batchSize int,
+	delay time.Duration,
+) ([]*CommandResponse, error) {
+	return r.sendBatches(ctx, "MoviesSearch", movieIDs, batchSize, delay)
+}
@@ -0,0 +1,109 @@
+package radarr_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/craigjmidwinter/starr"
+	"github.com/craigjmidwinter/starr/radarr"
+)
+
+func TestGetWantedMissingPage(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starr.TestMockData{
+		{
+			Name: "200",
+			ExpectedPath: path.Join("/", starr.API, radarr.APIver, "wanted", "missing") +
+				"?monitored=true&page=2&pageSize=1&sortDirection=ascending&sortKey=movieMetadata.sortTitle",
+			ResponseStatus: http.StatusOK,
+			ResponseBody: `{"page":2,"pageSize":1,"sortKey":"movieMetadata.sortTitle","sortDirection":"ascending",` +
+				`"totalRecords":3,"records":[{"id":17,"title":"Movie","monitored":true,"popularity":0}]}`,
+			WithRequest:    &starr.PageReq{Page: 2, PageSize: 1},
+			WithError:      nil,
+			ExpectedMethod: http.MethodGet,
+			WithResponse: &radarr.Wanted{
+				Page:          2,
+				PageSize:      1,
+				SortKey:       "movieMetadata.sortTitle",
+				SortDirection: "ascending",
+				TotalRecords:  3,
+				Records:       []*radarr.Movie{{ID: 17, Title: "Movie", Monitored: true}},
+			},
+		},
+		{
+			Name: "404",
+			ExpectedPath: path.Join("/", starr.API, radarr.APIver, "wanted", "missing") +
+				"?monitored=false&page=1&pageSize=10&sortDirection=descending&sortKey=movieMetadata.year",
+			ResponseStatus: http.StatusNotFound,
+			ResponseBody:   `{"message": "NotFound"}`,
+			WithRequest: &starr.PageReq{
+				SortKey: "movieMetadata.year",
+				SortDir: starr.SortDescend,
+				Values:  map[string][]string{"monitored": {"false"}},
+			},
+			WithError:      starr.ErrInvalidStatusCode,
+			ExpectedMethod: http.MethodGet,
+			WithResponse:   (*radarr.Wanted)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := radarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.GetWantedMissingPage(test.WithRequest.(*starr.PageReq))
+			assert.ErrorIs(t, err, test.WithError, "the wrong error was returned")
+			assert.EqualValues(t, test.WithResponse, output, "make sure ResponseBody and WithResponse are a match")
+		})
+	}
+}
+
+func TestSearchMovies(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mu      sync.Mutex
+		batches [][]int64
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var cmd radarr.CommandRequest
+
+		assert.Equal(t, path.Join("/", starr.API, radarr.APIver, "command"), r.URL.Path)
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&cmd))
+		assert.Equal(t, "MoviesSearch", cmd.Name)
+
+		mu.Lock()
+		batches = append(batches, cmd.MovieIDs)
+		mu.Unlock()
+
+		_, _ = w.Write([]byte(`{"id":1,"name":"MoviesSearch","status":"queued"}`))
+	}))
+	defer server.Close()
+
+	client := radarr.New(starr.New("mockAPIkey", server.URL, 0))
+	start := time.Now()
+	output, err := client.SearchMovies([]int64{1, 2, 3, 4, 5}, 2, 10*time.Millisecond)
+	require.NoError(t, err)
+	assert.Len(t, output, 3, "one response should be returned for every batch")
+	assert.Equal(t, [][]int64{{1, 2}, {3, 4}, {5}}, batches)
+	assert.GreaterOrEqual(t, time.Since(start), 20*time.Millisecond, "batches must be delayed")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	output, err = client.SearchMoviesContext(ctx, []int64{1, 2, 3}, 1, time.Hour)
+	assert.ErrorIs(t, err, context.DeadlineExceeded, "the delay must stop when the context is canceled")
+	assert.Len(t, output, 1, "the first batch is sent before any delay")
+}
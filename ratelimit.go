@@ -0,0 +1,135 @@
+package starr
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+/* This file contains the optional client-side rate limiter applied to every request made with a Config. */
+
+// RateLimit is an opt-in request limiter for a Config. Attach it to Config.Limit
+// to protect a Starr app (and its database) from too many requests at once.
+// Requests wait for a free slot, or until their context is canceled.
+// A RateLimit may be shared by many Configs to apply one limit across all of them.
+// Do not change the members after the first request is made.
+type RateLimit struct {
+	// PerSecond is the number of requests allowed each second. Zero disables rate limiting.
+	PerSecond float64
+	// Burst is the number of requests that may be made at once, ahead of the rate. Defaults to 1.
+	Burst int
+	// MaxInFlight is the number of requests allowed to be in progress at once. Zero is unlimited.
+	// A request is in progress until its response body is closed.
+	MaxInFlight int
+
+	mu     sync.Mutex
+	tokens float64
+	last   time.Time
+	slots  chan struct{}
+}
+
+// wait blocks until a request is allowed to proceed. The returned function must be called when it's finished.
+func (l *RateLimit) wait(ctx context.Context) (func(), error) {
+	if l == nil {
+		return func() {}, nil
+	}
+
+	release := func() {}
+
+	if slots := l.inFlight(); slots != nil {
+		select {
+		case slots <- struct{}{}:
+			once := sync.Once{}
+			release = func() { once.Do(func() { <-slots }) }
+		case <-ctx.Done():
+			return nil, fmt.Errorf("waiting for request slot: %w", ctx.Err())
+		}
+	}
+
+	if err := l.reserve(ctx); err != nil {
+		release()
+		return nil, err
+	}
+
+	return release, nil
+}
+
+// inFlight returns the channel used to cap concurrent requests, creating it on first use.
+func (l *RateLimit) inFlight() chan struct{} {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.slots == nil && l.MaxInFlight > 0 {
+		l.slots = make(chan struct{}, l.MaxInFlight)
+	}
+
+	return l.slots
+}
+
+// reserve takes a token from the bucket, waiting for one to be available if necessary.
+func (l *RateLimit) reserve(ctx context.Context) error {
+	if l.PerSecond <= 0 {
+		return nil
+	}
+
+	l.mu.Lock()
+
+	burst := float64(l.Burst)
+	if burst < 1 {
+		burst = 1
+	}
+
+	now := time.Now()
+	if l.last.IsZero() {
+		l.tokens = burst
+	} else if l.tokens += now.Sub(l.last).Seconds() * l.PerSecond; l.tokens > burst {
+		l.tokens = burst
+	}
+
+	l.last = now
+	l.tokens-- // Reserve a token, even if that puts us in debt; the wait below pays it back.
+	wait := time.Duration(-l.tokens / l.PerSecond * float64(time.Second))
+	l.mu.Unlock()
+
+	if wait <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		l.mu.Lock()
+		l.tokens++ // Give the unused token back.
+		l.mu.Unlock()
+
+		return fmt.Errorf("waiting for rate limit: %w", ctx.Err())
+	}
+}
+
+// releaseBody calls release when the response body is closed.
+type releaseBody struct {
+	io.ReadCloser
+	release func()
+}
+
+func (r *releaseBody) Close() error {
+	defer r.release()
+	return r.ReadCloser.Close()
+}
+
+// limitBody makes sure an in-flight slot is held until the response body is closed.
+func limitBody(resp *http.Response, release func()) {
+	if resp == nil || resp.Body == nil {
+		release()
+		return
+	}
+
+	resp.Body = &releaseBody{ReadCloser: resp.Body, release: release}
+}
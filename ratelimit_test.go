@@ -0,0 +1,91 @@
+package starr_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/craigjmidwinter/starr"
+)
+
+func TestRateLimitInFlight(t *testing.T) {
+	t.Parallel()
+
+	var current, peak int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		now := atomic.AddInt32(&current, 1)
+		defer atomic.AddInt32(&current, -1)
+
+		for old := atomic.LoadInt32(&peak); now > old; old = atomic.LoadInt32(&peak) {
+			if atomic.CompareAndSwapInt32(&peak, old, now) {
+				break
+			}
+		}
+
+		time.Sleep(10 * time.Millisecond)
+		_, _ = w.Write([]byte("{}"))
+	}))
+	defer server.Close()
+
+	config := starr.New("mockAPIkey", server.URL, 0)
+	config.Limit = &starr.RateLimit{MaxInFlight: 2}
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, config.GetInto(context.Background(), starr.Request{URI: "/v3/thing"}, &struct{}{}))
+		}()
+	}
+
+	wg.Wait()
+	assert.EqualValues(t, 2, atomic.LoadInt32(&peak), "no more than 2 requests may run at once")
+}
+
+func TestRateLimitPerSecond(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("{}"))
+	}))
+	defer server.Close()
+
+	config := starr.New("mockAPIkey", server.URL, 0)
+	config.Limit = &starr.RateLimit{PerSecond: 50, Burst: 2}
+	start := time.Now()
+
+	for i := 0; i < 7; i++ {
+		assert.NoError(t, config.GetInto(context.Background(), starr.Request{URI: "/v3/thing"}, &struct{}{}))
+	}
+
+	// 2 burst requests are free, the next 5 are spaced 20ms apart.
+	assert.GreaterOrEqual(t, time.Since(start), 90*time.Millisecond, "requests were not rate limited")
+}
+
+func TestRateLimitContext(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("{}"))
+	}))
+	defer server.Close()
+
+	config := starr.New("mockAPIkey", server.URL, 0)
+	config.Limit = &starr.RateLimit{PerSecond: 0.01}
+	assert.NoError(t, config.GetInto(context.Background(), starr.Request{URI: "/v3/thing"}, &struct{}{}))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	err := config.GetInto(ctx, starr.Request{URI: "/v3/thing"}, &struct{}{})
+	assert.ErrorIs(t, err, context.DeadlineExceeded, "the wait must stop when the context is canceled")
+}
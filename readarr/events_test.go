@@ -0,0 +1,70 @@
+package readarr_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/craigjmidwinter/starr"
+	"github.com/craigjmidwinter/starr/readarr"
+)
+
+func TestParseEvent(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		event    string
+		expected interface{}
+	}{
+		{
+			name:  "author",
+			event: `{"name":"author","body":{"action":"updated","resource":{"id":7,"authorName":"Writer"}}}`,
+			expected: &readarr.AuthorEvent{
+				Action: starr.EventActionUpdated,
+				Author: &readarr.Author{ID: 7, AuthorName: "Writer"},
+			},
+		},
+		{
+			name:  "book",
+			event: `{"name":"book","action":"deleted","body":{"resource":{"id":4,"title":"Novel","authorId":7}}}`,
+			expected: &readarr.BookEvent{
+				Action: starr.EventActionDeleted,
+				Book:   &readarr.Book{ID: 4, Title: "Novel", AuthorID: 7},
+			},
+		},
+		{
+			name:  "command",
+			event: `{"name":"command","body":{"action":"updated","resource":{"id":9,"name":"RefreshAuthor"}}}`,
+			expected: &readarr.CommandEvent{
+				Action:  starr.EventActionUpdated,
+				Command: &readarr.CommandResponse{ID: 9, Name: "RefreshAuthor"},
+			},
+		},
+		{
+			name:     "queue status",
+			event:    `{"name":"queue/status","action":"updated","body":{"resource":{"totalCount":3,"errors":true}}}`,
+			expected: &readarr.QueueStatusEvent{Action: starr.EventActionUpdated, Status: &readarr.QueueStatus{TotalCount: 3, Errors: true}},
+		},
+		{
+			name:     "unknown",
+			event:    `{"name":"calendar","action":"updated"}`,
+			expected: &starr.Event{Name: "calendar", Action: starr.EventActionUpdated},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			var event starr.Event
+			require.NoError(t, json.Unmarshal([]byte(test.event), &event))
+
+			output, err := readarr.ParseEvent(&event)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, output)
+		})
+	}
+}
@@ -59,28 +59,29 @@ func (r *Readarr) GetQueue(records, perPage int) (*Queue, error) {
 // If you need control over the page, use readarr.GetQueuePageContext().
 func (r *Readarr) GetQueueContext(ctx context.Context, records, perPage int) (*Queue, error) {
 	queue := &Queue{Records: []*QueueRecord{}}
-	perPage = starr.SetPerPage(records, perPage)
 
-	for page := 1; ; page++ {
-		curr, err := r.GetQueuePageContext(ctx, &starr.PageReq{PageSize: perPage, Page: page})
-		if err != nil {
-			return nil, err
-		}
+	err := starr.Paginate(ctx, &starr.PageReq{PageSize: perPage}, records,
+		func(ctx context.Context, params *starr.PageReq) (int, int, error) {
+			curr, err := r.GetQueuePageContext(ctx, params)
+			if err != nil {
+				return 0, 0, err
+			}
 
-		queue.Records = append(queue.Records, curr.Records...)
-
-		if len(queue.Records) >= curr.TotalRecords ||
-			(len(queue.Records) >= records && records != 0) ||
-			len(curr.Records) == 0 {
+			queue.Records = append(queue.Records, curr.Records...)
 			queue.PageSize = curr.TotalRecords
 			queue.TotalRecords = curr.TotalRecords
 			queue.SortDirection = curr.SortDirection
 			queue.SortKey = curr.SortKey
 
-			break
-		}
+			return len(curr.Records), curr.TotalRecords, nil
+		})
+	if err != nil {
+		return nil, err
+	}
 
-		perPage = starr.AdjustPerPage(records, curr.TotalRecords, len(queue.Records), perPage)
+	// The last page may return more records than requested.
+	if records > 0 && len(queue.Records) > records {
+		queue.Records = queue.Records[:records]
 	}
 
 	return queue, nil
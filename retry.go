@@ -0,0 +1,189 @@
+package starr
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+/* This file contains the optional retry policy applied to every request made with a Config. */
+
+// Defaults for Retry.
+const (
+	DefaultRetryMinWait = 500 * time.Millisecond
+	DefaultRetryMaxWait = 30 * time.Second
+)
+
+// Retry is an opt-in retry policy for a Config. Attach it to Config.Retry, and
+// every request made by every app package is retried when it fails with a
+// network error or a 408, 429, 502, 503 or 504 status code.
+// Backoff doubles from MinWait up to MaxWait, with random jitter applied.
+// A Retry-After header sent with the response is honored, but capped to MaxWait.
+// Errors building the request, like a malformed URL, are never retried.
+// Only idempotent methods (GET, HEAD, OPTIONS, PUT, DELETE) are retried unless AllMethods is true.
+// Waiting between attempts stops when the request context is canceled.
+// A Retry may be shared by many Configs.
+type Retry struct {
+	// MaxAttempts is the total number of tries, including the first. Less than 2 disables retries.
+	MaxAttempts int
+	// MinWait is the first backoff delay. DefaultRetryMinWait is used if this is zero.
+	MinWait time.Duration
+	// MaxWait is the longest backoff delay. DefaultRetryMaxWait is used if this is zero.
+	MaxWait time.Duration
+	// AllMethods allows retrying POST requests. Only enable this if duplicate POSTs are harmless to you.
+	AllMethods bool
+	// OnRetry is optional, and called before waiting for each retry.
+	// attempt is the number of the attempt that failed, starting at 1.
+	OnRetry func(attempt int, wait time.Duration, err error)
+}
+
+// retries returns true if this retry policy applies to the provided request method.
+func (r *Retry) retries(method string) bool {
+	if r == nil || r.MaxAttempts < 2 { //nolint:gomnd
+		return false
+	}
+
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return r.AllMethods
+	}
+}
+
+// do runs a request through send until it succeeds, fails permanently, or runs out of attempts.
+func (r *Retry) do(
+	ctx context.Context,
+	method string,
+	req Request,
+	send func(context.Context, string, Request) (*http.Response, error),
+) (*http.Response, error) {
+	var body []byte
+
+	if req.Body != nil { // The body must be re-readable for every attempt.
+		var err error
+		if body, err = io.ReadAll(req.Body); err != nil {
+			return nil, fmt.Errorf("reading request body: %w", err)
+		}
+	}
+
+	for attempt := 1; ; attempt++ {
+		if body != nil {
+			req.Body = bytes.NewReader(body)
+		}
+
+		resp, err := send(ctx, method, req)
+		if err == nil && resp.StatusCode >= 200 && resp.StatusCode <= 299 {
+			return resp, nil
+		}
+
+		retry := r.retryable(ctx, resp, err) && attempt < r.MaxAttempts
+		wait := r.backoff(attempt, resp)
+
+		if err == nil { // turn the bad status code into an error, this closes the body.
+			err = parseNon200(resp)
+		}
+
+		if !retry {
+			return nil, err
+		}
+
+		if r.OnRetry != nil {
+			r.OnRetry(attempt, wait, err)
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, fmt.Errorf("giving up after %d attempts: %w (%v)", attempt, ctx.Err(), err)
+		case <-timer.C:
+		}
+	}
+}
+
+// retryable decides if a response or error is worth trying again.
+func (r *Retry) retryable(ctx context.Context, resp *http.Response, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+
+	if err != nil {
+		var transportErr *transportError
+
+		return errors.As(err, &transportErr) &&
+			!errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusRequestTimeout, http.StatusTooManyRequests, http.StatusBadGateway,
+		http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+// backoff returns how long to wait before the next attempt.
+func (r *Retry) backoff(attempt int, resp *http.Response) time.Duration {
+	minWait, maxWait := r.MinWait, r.MaxWait
+	if minWait <= 0 {
+		minWait = DefaultRetryMinWait
+	}
+
+	if maxWait <= 0 {
+		maxWait = DefaultRetryMaxWait
+	}
+
+	if wait, ok := retryAfter(resp); ok {
+		if wait > maxWait {
+			return maxWait
+		}
+
+		return wait
+	}
+
+	wait := minWait
+	for i := 1; i < attempt && wait < maxWait; i++ {
+		wait *= 2
+	}
+
+	if wait > maxWait {
+		wait = maxWait
+	}
+
+	// Jitter: wait somewhere between half and all of the calculated backoff.
+	return wait/2 + time.Duration(rand.Int63n(int64(wait/2)+1)) //nolint:gosec
+}
+
+// retryAfter parses a Retry-After header in either of its two formats: seconds or an HTTP date.
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	if resp == nil {
+		return 0, false
+	}
+
+	header := resp.Header.Get("Retry-After")
+	if header == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(header); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(header); err == nil {
+		if wait := time.Until(date); wait > 0 {
+			return wait, true
+		}
+
+		return 0, true
+	}
+
+	return 0, false
+}
@@ -0,0 +1,175 @@
+package starr_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/craigjmidwinter/starr"
+)
+
+// flakyServer returns failure status codes until it has been called `fail` times, then returns 200.
+func flakyServer(t *testing.T, fail int32, status int, calls *int32) *httptest.Server {
+	t.Helper()
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+
+		if atomic.AddInt32(calls, 1) <= fail {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(status)
+			_, _ = w.Write([]byte(`{"message": "try again"}`))
+
+			return
+		}
+
+		_, _ = w.Write(append([]byte(`{"body": "`), append(body, '"', '}')...))
+	}))
+}
+
+func TestRetry(t *testing.T) {
+	t.Parallel()
+
+	var calls, retries int32
+
+	server := flakyServer(t, 2, http.StatusServiceUnavailable, &calls)
+	defer server.Close()
+
+	config := starr.New("mockAPIkey", server.URL, 0)
+	config.Retry = &starr.Retry{
+		MaxAttempts: 3,
+		MinWait:     time.Millisecond,
+		OnRetry: func(attempt int, wait time.Duration, err error) {
+			atomic.AddInt32(&retries, 1)
+			assert.ErrorIs(t, err, starr.ErrInvalidStatusCode)
+			assert.Zero(t, wait, "the Retry-After header should be honored")
+		},
+	}
+
+	var output struct{ Body string }
+
+	require.NoError(t, config.PutInto(context.Background(), starr.Request{
+		URI:  "/v3/thing",
+		Body: strings.NewReader("payload"),
+	}, &output))
+	assert.Equal(t, "payload", output.Body, "the request body must be replayed for every attempt")
+	assert.EqualValues(t, 3, atomic.LoadInt32(&calls))
+	assert.EqualValues(t, 2, atomic.LoadInt32(&retries))
+}
+
+func TestRetryExhausted(t *testing.T) {
+	t.Parallel()
+
+	var calls int32
+
+	server := flakyServer(t, 5, http.StatusBadGateway, &calls)
+	defer server.Close()
+
+	config := starr.New("mockAPIkey", server.URL, 0)
+	config.Retry = &starr.Retry{MaxAttempts: 2, MinWait: time.Millisecond}
+
+	err := config.GetInto(context.Background(), starr.Request{URI: "/v3/thing"}, &struct{}{})
+	assert.ErrorIs(t, err, starr.ErrInvalidStatusCode)
+	assert.EqualValues(t, 2, atomic.LoadInt32(&calls))
+}
+
+func TestRetrySkipsPost(t *testing.T) {
+	t.Parallel()
+
+	var calls int32
+
+	server := flakyServer(t, 1, http.StatusServiceUnavailable, &calls)
+	defer server.Close()
+
+	config := starr.New("mockAPIkey", server.URL, 0)
+	config.Retry = &starr.Retry{MaxAttempts: 3, MinWait: time.Millisecond}
+
+	err := config.PostInto(context.Background(), starr.Request{URI: "/v3/thing"}, &struct{}{})
+	assert.ErrorIs(t, err, starr.ErrInvalidStatusCode)
+	assert.EqualValues(t, 1, atomic.LoadInt32(&calls), "POST must not be retried by default")
+
+	config.Retry.AllMethods = true
+	assert.NoError(t, config.PostInto(context.Background(), starr.Request{URI: "/v3/thing"}, &struct{}{}))
+}
+
+func TestRetryNotFound(t *testing.T) {
+	t.Parallel()
+
+	var calls int32
+
+	server := flakyServer(t, 1, http.StatusNotFound, &calls)
+	defer server.Close()
+
+	config := starr.New("mockAPIkey", server.URL, 0)
+	config.Retry = &starr.Retry{MaxAttempts: 3, MinWait: time.Millisecond}
+
+	err := config.GetInto(context.Background(), starr.Request{URI: "/v3/thing"}, &struct{}{})
+	assert.ErrorIs(t, err, starr.ErrInvalidStatusCode)
+	assert.EqualValues(t, 1, atomic.LoadInt32(&calls), "a 404 is not retryable")
+}
+
+func TestRetryNetworkError(t *testing.T) {
+	t.Parallel()
+
+	var retries int32
+
+	server := httptest.NewServer(http.NotFoundHandler())
+	server.Close() // nothing is listening now, so every attempt fails to connect.
+
+	config := starr.New("mockAPIkey", server.URL, 0)
+	config.Retry = &starr.Retry{
+		MaxAttempts: 3,
+		MinWait:     time.Millisecond,
+		OnRetry:     func(int, time.Duration, error) { atomic.AddInt32(&retries, 1) },
+	}
+
+	assert.Error(t, config.GetInto(context.Background(), starr.Request{URI: "/v3/thing"}, &struct{}{}))
+	assert.EqualValues(t, 2, atomic.LoadInt32(&retries), "connection errors must be retried")
+}
+
+func TestRetryBadURL(t *testing.T) {
+	t.Parallel()
+
+	var retries int32
+
+	config := starr.New("mockAPIkey", "://bad-url", 0)
+	config.Retry = &starr.Retry{
+		MaxAttempts: 3,
+		MinWait:     time.Millisecond,
+		OnRetry:     func(int, time.Duration, error) { atomic.AddInt32(&retries, 1) },
+	}
+
+	assert.Error(t, config.GetInto(context.Background(), starr.Request{URI: "/v3/thing"}, &struct{}{}))
+	assert.Zero(t, atomic.LoadInt32(&retries), "a request that cannot be built must not be retried")
+}
+
+func TestRetryContext(t *testing.T) {
+	t.Parallel()
+
+	var calls int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	config := starr.New("mockAPIkey", server.URL, 0)
+	config.Retry = &starr.Retry{MaxAttempts: 10, MinWait: time.Hour}
+
+	start := time.Now()
+	err := config.GetInto(ctx, starr.Request{URI: "/v3/thing"}, &struct{}{})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Less(t, time.Since(start), time.Minute, "canceling the context must stop the backoff")
+	assert.EqualValues(t, 1, atomic.LoadInt32(&calls))
+}
@@ -55,7 +55,7 @@ func Client(timeout time.Duration, verifySSL bool) *http.Client {
 // ClientWithDebug returns an http client with a debug logger enabled.
 func ClientWithDebug(timeout time.Duration, verifySSL bool, logConfig debuglog.Config) *http.Client {
 	client := Client(timeout, verifySSL)
-	client.Transport = debuglog.NewLoggingRoundTripper(logConfig, nil)
+	client.Transport = debuglog.NewLoggingRoundTripper(logConfig, client.Transport)
 
 	return client
 }
@@ -247,3 +247,13 @@ const (
 func (a ApplyTags) Ptr() *ApplyTags {
 	return &a
 }
+
+// ImportMode is an enum used as an input for the ManualImport command in Sonarr and Radarr.
+type ImportMode string
+
+// ImportMode enum constants. Auto moves files from download clients that are done seeding, and copies the rest.
+const (
+	ImportModeAuto ImportMode = "auto"
+	ImportModeMove ImportMode = "move"
+	ImportModeCopy ImportMode = "copy"
+)
@@ -0,0 +1,539 @@
+package starr
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/net/websocket"
+)
+
+/* This file contains a streaming client for the SignalR hub every Starr app provides.
+ * The apps use it to push live updates to their web UI. We use the JSON hub protocol over a websocket.
+ * https://github.com/dotnet/aspnetcore/blob/main/src/SignalR/docs/specs/HubProtocol.md
+ */
+
+// Defaults for StreamConfig.
+const (
+	DefaultStreamMinWait = time.Second
+	DefaultStreamMaxWait = time.Minute
+	DefaultStreamBuffer  = 100
+)
+
+const (
+	signalRPath     = "/signalr/messages"
+	signalRSep      = "\x1e" // every SignalR JSON record ends with this.
+	signalRPing     = 15 * time.Second
+	signalRTimeout  = 30 * time.Second
+	signalRReceive  = "receiveMessage"
+	signalRInvoke   = 1
+	signalRClose    = 7
+	signalRProtocol = `{"protocol":"json","version":1}`
+)
+
+// Errors returned by event streams.
+var (
+	ErrStreamClosed      = fmt.Errorf("event stream closed by server")
+	ErrStreamUnsupported = fmt.Errorf("the provided APIer cannot stream events")
+	ErrStreamProxy       = fmt.Errorf("event streams cannot connect through a proxy")
+)
+
+// EventStreamer is satisfied by a Config. The app packages use it to open typed event streams.
+type EventStreamer interface {
+	StreamEvents(ctx context.Context, config *StreamConfig) (<-chan *Event, error)
+}
+
+// Config must satisfy the EventStreamer interface.
+var _ EventStreamer = (*Config)(nil)
+
+// EventAction is the action attached to an Event.
+type EventAction string
+
+// EventAction enum constants. These are the only actions the apps send.
+const (
+	EventActionUnknown EventAction = "unknown"
+	EventActionCreated EventAction = "created"
+	EventActionUpdated EventAction = "updated"
+	EventActionDeleted EventAction = "deleted"
+	EventActionSync    EventAction = "sync"
+)
+
+// UnmarshalJSON accepts an action as a string or as the integer enum value some app versions send.
+func (a *EventAction) UnmarshalJSON(b []byte) error {
+	actions := []EventAction{EventActionUnknown, EventActionCreated, EventActionUpdated, EventActionDeleted, EventActionSync}
+
+	if num, err := strconv.Atoi(string(b)); err == nil {
+		if num < 0 || num >= len(actions) {
+			num = 0
+		}
+
+		*a = actions[num]
+
+		return nil
+	}
+
+	*a = EventAction(strings.ToLower(strings.Trim(string(b), `"`)))
+
+	return nil
+}
+
+// Event is a message pushed from a Starr app's SignalR hub.
+// The app packages have methods to turn these into typed events; like sonarr.ParseEvent().
+type Event struct {
+	// Name is the resource the event is about, like "queue", "command" or "health".
+	Name string `json:"name"`
+	// Action is what happened to the resource. Sometimes this is only found in the body.
+	Action EventAction `json:"action"`
+	// Body is the raw event payload. It usually contains "resource" and "action".
+	Body json.RawMessage `json:"body"`
+}
+
+// eventBody is the payload found in most events.
+type eventBody struct {
+	Action   EventAction     `json:"action"`
+	Resource json.RawMessage `json:"resource"`
+}
+
+// Resource unmarshals the resource from the event body into the provided pointer interface.
+// If the event has no resource, output is not modified and no error is returned.
+func (e *Event) Resource(output interface{}) error {
+	var body eventBody
+
+	if len(e.Body) == 0 {
+		return nil
+	} else if err := json.Unmarshal(e.Body, &body); err != nil {
+		return fmt.Errorf("decoding event body: %w", err)
+	}
+
+	if len(body.Resource) == 0 || string(body.Resource) == "null" {
+		return nil
+	}
+
+	if err := json.Unmarshal(body.Resource, output); err != nil {
+		return fmt.Errorf("decoding event resource: %w", err)
+	}
+
+	return nil
+}
+
+// UnmarshalJSON copies the action from the body when the event itself does not have one.
+func (e *Event) UnmarshalJSON(b []byte) error {
+	type event Event // avoid recursion.
+	if err := json.Unmarshal(b, (*event)(e)); err != nil {
+		return fmt.Errorf("decoding event: %w", err)
+	}
+
+	if e.Action != "" && e.Action != EventActionUnknown {
+		return nil
+	}
+
+	var body eventBody
+	if json.Unmarshal(e.Body, &body) == nil && body.Action != "" {
+		e.Action = body.Action
+	}
+
+	if e.Action == "" {
+		e.Action = EventActionUnknown
+	}
+
+	return nil
+}
+
+// StreamConfig is the optional input for StreamEvents.
+type StreamConfig struct {
+	// MinWait is the first delay before reconnecting. DefaultStreamMinWait is used if this is zero.
+	MinWait time.Duration
+	// MaxWait is the longest delay between reconnects. DefaultStreamMaxWait is used if this is zero.
+	MaxWait time.Duration
+	// Buffer is the size of the event channel. DefaultStreamBuffer is used if this is zero.
+	Buffer int
+	// OnError is called with every connection failure, before reconnecting. Optional.
+	OnError func(err error)
+}
+
+// StreamEvents connects to the Starr app's SignalR hub and returns a channel of live events.
+// The first connection is made before returning, and an error is returned if it fails.
+// After that, the stream reconnects with backoff whenever the connection drops.
+// The channel is closed after the context is canceled. You must read from the channel.
+// This works with every Starr app; the API key is used for authentication.
+// The stream dials the app directly with the client's TLS settings. Proxies are not supported; when the
+// client's transport would use a proxy for the app, ErrStreamProxy is returned.
+func (c *Config) StreamEvents(ctx context.Context, config *StreamConfig) (<-chan *Event, error) {
+	if c.Client == nil {
+		return nil, ErrNilClient
+	}
+
+	if config == nil {
+		config = &StreamConfig{}
+	}
+
+	conn, err := c.signalRConnect(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	buffer := config.Buffer
+	if buffer <= 0 {
+		buffer = DefaultStreamBuffer
+	}
+
+	events := make(chan *Event, buffer)
+	go c.signalRStream(ctx, conn, events, config)
+
+	return events, nil
+}
+
+// StreamParsed is used by the app packages to provide typed event streams. It calls StreamEvents on the
+// APIer and converts every event with parse. Events that fail to parse are sent to config.OnError
+// (if provided) and passed through as *Event. This requires a *Config as the APIer.
+func StreamParsed(
+	ctx context.Context,
+	api APIer,
+	config *StreamConfig,
+	parse func(*Event) (interface{}, error),
+) (<-chan interface{}, error) {
+	streamer, ok := api.(EventStreamer)
+	if !ok {
+		return nil, ErrStreamUnsupported
+	}
+
+	events, err := streamer.StreamEvents(ctx, config)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	output := make(chan interface{}, cap(events))
+
+	go func() {
+		defer close(output)
+
+		for event := range events {
+			parsed, err := parse(event)
+			if err != nil {
+				if parsed = event; config != nil && config.OnError != nil {
+					config.OnError(err)
+				}
+			}
+
+			select {
+			case output <- parsed:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return output, nil
+}
+
+// signalRStream reads from a connection until the context is canceled, reconnecting as needed.
+func (c *Config) signalRStream(ctx context.Context, conn *websocket.Conn, events chan<- *Event, config *StreamConfig) {
+	defer close(events)
+
+	minWait, maxWait := config.MinWait, config.MaxWait
+	if minWait <= 0 {
+		minWait = DefaultStreamMinWait
+	}
+
+	if maxWait <= 0 {
+		maxWait = DefaultStreamMaxWait
+	}
+
+	for {
+		err := signalRRead(ctx, conn, events)
+
+		for wait := minWait; ; {
+			if ctx.Err() != nil {
+				return
+			}
+
+			if config.OnError != nil {
+				config.OnError(err)
+			}
+
+			timer := time.NewTimer(wait)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return
+			case <-timer.C:
+			}
+
+			if conn, err = c.signalRConnect(ctx); err == nil {
+				break
+			}
+
+			if wait *= 2; wait > maxWait {
+				wait = maxWait
+			}
+		}
+	}
+}
+
+// signalRRead delivers events from a connection until it fails or the context is canceled.
+func signalRRead(ctx context.Context, conn *websocket.Conn, events chan<- *Event) error {
+	defer conn.Close()
+
+	done := make(chan struct{})
+	defer close(done)
+
+	go func() { // Keep the connection alive, and close it when the context is canceled.
+		ticker := time.NewTicker(signalRPing)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				conn.Close()
+				return
+			case <-done:
+				return
+			case <-ticker.C:
+				_ = websocket.Message.Send(conn, `{"type":6}`+signalRSep)
+			}
+		}
+	}()
+
+	for {
+		var frame string
+		if err := websocket.Message.Receive(conn, &frame); err != nil {
+			return fmt.Errorf("reading event stream: %w", err)
+		}
+
+		for _, record := range strings.Split(frame, signalRSep) {
+			if record == "" {
+				continue
+			}
+
+			var msg struct {
+				Type      int      `json:"type"`
+				Target    string   `json:"target"`
+				Error     string   `json:"error"`
+				Arguments []*Event `json:"arguments"`
+			}
+
+			if err := json.Unmarshal([]byte(record), &msg); err != nil {
+				return fmt.Errorf("decoding event stream message: %w", err)
+			}
+
+			switch {
+			case msg.Type == signalRClose:
+				return fmt.Errorf("%w: %s", ErrStreamClosed, msg.Error)
+			case msg.Type != signalRInvoke || msg.Target != signalRReceive:
+				continue // pings and other messages we do not care about.
+			}
+
+			for _, event := range msg.Arguments {
+				select {
+				case events <- event:
+				case <-ctx.Done():
+					return ctx.Err() //nolint:wrapcheck
+				}
+			}
+		}
+	}
+}
+
+// signalRConnect negotiates a connection, opens a websocket and completes the SignalR handshake.
+func (c *Config) signalRConnect(ctx context.Context) (*websocket.Conn, error) {
+	var negotiate struct {
+		ConnectionID    string `json:"connectionId"`
+		ConnectionToken string `json:"connectionToken"`
+	}
+
+	req := Request{
+		URI:   signalRPath + "/negotiate",
+		Query: url.Values{"negotiateVersion": []string{"1"}, "access_token": []string{c.APIKey}},
+	}
+
+	resp, err := c.Req(ctx, http.MethodPost, req)
+	if err = decode(&negotiate, resp, err); err != nil {
+		return nil, fmt.Errorf("negotiating event stream: %w", err)
+	}
+
+	if negotiate.ConnectionToken == "" {
+		negotiate.ConnectionToken = negotiate.ConnectionID
+	}
+
+	wsURL, err := url.Parse(strings.TrimSuffix(c.URL, "/") + signalRPath)
+	if err != nil {
+		return nil, fmt.Errorf("parsing app url: %w", err)
+	}
+
+	if wsURL.Scheme == "https" {
+		wsURL.Scheme = "wss"
+	} else {
+		wsURL.Scheme = "ws"
+	}
+
+	wsURL.RawQuery = url.Values{"id": []string{negotiate.ConnectionToken}, "access_token": []string{c.APIKey}}.Encode()
+
+	conn, err := c.signalRDial(ctx, wsURL)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := signalRHandshake(conn); err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	return conn, nil
+}
+
+// signalRDial opens a websocket. The TLS and dial settings come from the http client's
+// transport, so the stream connects the same way API requests do.
+func (c *Config) signalRDial(ctx context.Context, wsURL *url.URL) (*websocket.Conn, error) {
+	wsConfig, err := websocket.NewConfig(wsURL.String(), c.URL)
+	if err != nil {
+		return nil, fmt.Errorf("websocket.NewConfig: %w", err)
+	}
+
+	// Reuse our normal request headers for authentication.
+	httpReq, _ := http.NewRequestWithContext(ctx, http.MethodGet, wsURL.String(), nil)
+	c.SetHeaders(httpReq)
+	wsConfig.Header = httpReq.Header
+
+	transport := httpTransport(c.Client.Transport)
+
+	netConn, err := signalRNetConn(ctx, transport, wsURL)
+	if err != nil {
+		return nil, err
+	}
+
+	if wsURL.Scheme == "wss" {
+		tlsConfig := &tls.Config{} //nolint:gosec
+		if transport != nil && transport.TLSClientConfig != nil {
+			tlsConfig = transport.TLSClientConfig.Clone()
+		}
+
+		if tlsConfig.ServerName == "" {
+			tlsConfig.ServerName = wsURL.Hostname()
+		}
+
+		tlsConn := tls.Client(netConn, tlsConfig)
+		if err := tlsConn.HandshakeContext(ctx); err != nil {
+			netConn.Close()
+			return nil, fmt.Errorf("event stream tls handshake: %w", err)
+		}
+
+		netConn = tlsConn
+	}
+
+	// The websocket handshake does not take a context, so use a deadline.
+	_ = netConn.SetDeadline(time.Now().Add(signalRTimeout))
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = netConn.SetDeadline(deadline)
+	}
+
+	conn, err := websocket.NewClient(wsConfig, netConn)
+	if err != nil {
+		netConn.Close()
+		return nil, fmt.Errorf("opening event stream: %w", err)
+	}
+
+	_ = netConn.SetDeadline(time.Time{})
+
+	return conn, nil
+}
+
+// httpTransport finds the *http.Transport in an http client's round tripper. Wrappers, like the
+// debug logger and the response cache, provide the round tripper they wrap with Unwrap().
+// Returns nil if there isn't one.
+func httpTransport(next http.RoundTripper) *http.Transport {
+	const maxDepth = 10 // in case a wrapper wraps itself.
+
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	for i := 0; i < maxDepth; i++ {
+		switch rt := next.(type) {
+		case *http.Transport:
+			return rt
+		case interface{ Unwrap() http.RoundTripper }:
+			next = rt.Unwrap()
+		default:
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// signalRNetConn opens a network connection to the app with the transport's dialer.
+// Event streams cannot use a proxy, so this fails if the transport would send the app's requests through one.
+func signalRNetConn(ctx context.Context, transport *http.Transport, wsURL *url.URL) (net.Conn, error) {
+	dial := (&net.Dialer{Timeout: signalRTimeout}).DialContext
+
+	if transport != nil && transport.DialContext != nil {
+		dial = transport.DialContext
+	}
+
+	if transport != nil && transport.Proxy != nil {
+		// Proxy functions choose a proxy by scheme, so ask about the http equivalent of the websocket URL.
+		httpURL := *wsURL
+		httpURL.Scheme = strings.Replace(wsURL.Scheme, "ws", "http", 1)
+
+		proxyURL, err := transport.Proxy(&http.Request{URL: &httpURL, Header: make(http.Header)})
+		if err != nil {
+			return nil, fmt.Errorf("finding event stream proxy: %w", err)
+		} else if proxyURL != nil {
+			return nil, fmt.Errorf("%w: %s", ErrStreamProxy, proxyURL.Redacted())
+		}
+	}
+
+	conn, err := dial(ctx, "tcp", hostPort(wsURL))
+	if err != nil {
+		return nil, fmt.Errorf("dialing event stream: %w", err)
+	}
+
+	return conn, nil
+}
+
+// hostPort returns the host and port from a URL, adding the default port for the scheme if it's missing.
+func hostPort(u *url.URL) string {
+	if u.Port() != "" {
+		return u.Host
+	}
+
+	if u.Scheme == "wss" {
+		return net.JoinHostPort(u.Hostname(), "443")
+	}
+
+	return net.JoinHostPort(u.Hostname(), "80")
+}
+
+// signalRHandshake selects the JSON protocol and waits for the server to accept it.
+func signalRHandshake(conn *websocket.Conn) error {
+	_ = conn.SetDeadline(time.Now().Add(signalRTimeout))
+	defer conn.SetDeadline(time.Time{}) //nolint:errcheck
+
+	if err := websocket.Message.Send(conn, signalRProtocol+signalRSep); err != nil {
+		return fmt.Errorf("sending event stream handshake: %w", err)
+	}
+
+	var frame string
+	if err := websocket.Message.Receive(conn, &frame); err != nil {
+		return fmt.Errorf("reading event stream handshake: %w", err)
+	}
+
+	var reply struct {
+		Error string `json:"error"`
+	}
+
+	if err := json.Unmarshal([]byte(strings.SplitN(frame, signalRSep, 2)[0]), &reply); err != nil { //nolint:gomnd
+		return fmt.Errorf("decoding event stream handshake: %w", err)
+	} else if reply.Error != "" {
+		return fmt.Errorf("%w: handshake rejected: %s", ErrRequestError, reply.Error)
+	}
+
+	return nil
+}
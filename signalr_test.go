@@ -0,0 +1,216 @@
+package starr_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/craigjmidwinter/starr"
+	"github.com/craigjmidwinter/starr/debuglog"
+	"golang.org/x/net/websocket"
+)
+
+// signalRHub is a tiny SignalR hub. Every connection completes the handshake, sends one event and hangs up.
+func signalRHub(t *testing.T, connections *int32) http.Handler {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/signalr/messages/negotiate", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "mockAPIkey", r.Header.Get("X-API-Key"))
+		_, _ = w.Write([]byte(`{"connectionToken":"token","negotiateVersion":1}`))
+	})
+	mux.Handle("/signalr/messages", websocket.Handler(func(conn *websocket.Conn) {
+		assert.Equal(t, "token", conn.Request().URL.Query().Get("id"))
+
+		var handshake string
+		if !assert.NoError(t, websocket.Message.Receive(conn, &handshake)) {
+			return
+		}
+
+		assert.Equal(t, `{"protocol":"json","version":1}`+"\x1e", handshake)
+
+		num := atomic.AddInt32(connections, 1)
+		msg, _ := json.Marshal(map[string]interface{}{
+			"type":   1,
+			"target": "receiveMessage",
+			"arguments": []interface{}{map[string]interface{}{
+				"name": "queue",
+				"body": map[string]interface{}{"action": "updated", "resource": map[string]interface{}{"id": num}},
+			}},
+		})
+
+		_ = websocket.Message.Send(conn, "{}\x1e{\"type\":6}\x1e")
+		_ = websocket.Message.Send(conn, string(msg)+"\x1e")
+		_ = websocket.Message.Send(conn, `{"type":7,"error":"bye"}`+"\x1e")
+	}))
+
+	return mux
+}
+
+func TestStreamEvents(t *testing.T) {
+	t.Parallel()
+
+	var connections, errors int32
+
+	server := httptest.NewServer(signalRHub(t, &connections))
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	config := starr.New("mockAPIkey", server.URL, 0)
+	events, err := config.StreamEvents(ctx, &starr.StreamConfig{
+		MinWait: time.Millisecond,
+		OnError: func(err error) {
+			assert.ErrorIs(t, err, starr.ErrStreamClosed)
+			atomic.AddInt32(&errors, 1)
+		},
+	})
+	require.NoError(t, err)
+
+	for i := int64(1); i <= 3; i++ {
+		event := <-events
+		require.NotNil(t, event)
+		assert.Equal(t, "queue", event.Name)
+		assert.Equal(t, starr.EventActionUpdated, event.Action, "the action must be copied from the body")
+
+		var resource struct {
+			ID int64 `json:"id"`
+		}
+
+		require.NoError(t, event.Resource(&resource))
+		assert.Equal(t, i, resource.ID, "every reconnect should deliver a new event")
+	}
+
+	assert.GreaterOrEqual(t, atomic.LoadInt32(&errors), int32(2), "dropped connections must be reported")
+	cancel()
+
+	for range events { //nolint:revive // drain until closed.
+	}
+}
+
+// firstEvent starts a stream, and returns the first event's resource ID.
+func firstEvent(t *testing.T, config *starr.Config) int64 {
+	t.Helper()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	events, err := config.StreamEvents(ctx, &starr.StreamConfig{MinWait: time.Millisecond})
+	require.NoError(t, err)
+
+	event := <-events
+	require.NotNil(t, event)
+
+	var resource struct {
+		ID int64 `json:"id"`
+	}
+
+	require.NoError(t, event.Resource(&resource))
+
+	return resource.ID
+}
+
+func TestStreamEventsTLS(t *testing.T) {
+	t.Parallel()
+
+	var connections int32
+
+	server := httptest.NewTLSServer(signalRHub(t, &connections))
+	defer server.Close()
+
+	// The stream must use the TLS settings inside the debug logger, just like API requests do.
+	config := starr.New("mockAPIkey", server.URL, 0)
+	config.Client = starr.ClientWithDebug(0, false, debuglog.Config{Debugf: func(string, ...interface{}) {}})
+
+	assert.EqualValues(t, 1, firstEvent(t, config))
+}
+
+func TestStreamEventsProxy(t *testing.T) {
+	t.Parallel()
+
+	var connections int32
+
+	server := httptest.NewServer(signalRHub(t, &connections))
+	defer server.Close()
+
+	// The hub answers the proxied negotiate request itself, but the websocket must not bypass the proxy.
+	proxyURL, _ := url.Parse(server.URL)
+	config := starr.New("mockAPIkey", server.URL, 0)
+	config.Client.Transport = starr.NewCachingRoundTripper(starr.CacheConfig{}, &http.Transport{
+		Proxy: http.ProxyURL(proxyURL),
+	})
+
+	_, err := config.StreamEvents(context.Background(), nil)
+	assert.ErrorIs(t, err, starr.ErrStreamProxy, "proxies are not supported")
+	assert.Zero(t, atomic.LoadInt32(&connections))
+}
+
+func TestStreamParsed(t *testing.T) {
+	t.Parallel()
+
+	var connections, parseErrors int32
+
+	server := httptest.NewServer(signalRHub(t, &connections))
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	errBadEvent := errors.New("bad event")
+	config := &starr.StreamConfig{
+		MinWait: time.Millisecond,
+		OnError: func(err error) {
+			if errors.Is(err, errBadEvent) {
+				atomic.AddInt32(&parseErrors, 1)
+			}
+		},
+	}
+
+	events, err := starr.StreamParsed(ctx, starr.New("mockAPIkey", server.URL, 0), config,
+		func(event *starr.Event) (interface{}, error) {
+			if event.Name == "queue" {
+				return nil, errBadEvent
+			}
+
+			return event.Name, nil
+		})
+	require.NoError(t, err)
+
+	event, ok := (<-events).(*starr.Event)
+	require.True(t, ok, "events that fail to parse must be passed through")
+	assert.Equal(t, "queue", event.Name)
+	assert.GreaterOrEqual(t, atomic.LoadInt32(&parseErrors), int32(1), "parse errors must be reported")
+}
+
+func TestStreamEventsNegotiateError(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	_, err := starr.New("badkey", server.URL, 0).StreamEvents(context.Background(), nil)
+	assert.ErrorIs(t, err, starr.ErrInvalidAPIKey, "the first connection error must be returned")
+}
+
+func TestEventActionUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	var event starr.Event
+
+	require.NoError(t, json.Unmarshal([]byte(`{"name":"series","action":3}`), &event))
+	assert.Equal(t, starr.EventActionDeleted, event.Action)
+	require.NoError(t, json.Unmarshal([]byte(`{"name":"series","action":"Sync"}`), &event))
+	assert.Equal(t, starr.EventActionSync, event.Action)
+}
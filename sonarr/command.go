@@ -13,15 +13,31 @@ import (
 
 const bpCommand = APIver + "/command"
 
+// DefaultCommandPoll is how often a command's status is checked while waiting for it to finish.
+const DefaultCommandPoll = 5 * time.Second
+
+// These are the states a command moves through. Any state
+// other than queued or started means the command is finished.
+const (
+	CommandQueued    = "queued"
+	CommandStarted   = "started"
+	CommandCompleted = "completed"
+	CommandFailed    = "failed"
+	CommandAborted   = "aborted"
+)
+
 // CommandRequest goes into the /api/v3/command endpoint.
 // This was created from the search command and may not support other commands yet.
+// ManualImport and ImportMode are only used with the ManualImport command.
 type CommandRequest struct {
-	Name       string  `json:"name"`
-	Files      []int64 `json:"files,omitempty"` // RenameFiles only
-	SeriesIDs  []int64 `json:"seriesIds,omitempty"`
-	SeriesID   int64   `json:"seriesId,omitempty"`
-	EpisodeIDs []int64 `json:"episodeIds,omitempty"`
-	EpisodeID  int64   `json:"episodeId,omitempty"`
+	Name         string               `json:"name"`
+	Files        []int64              `json:"files,omitempty"` // RenameFiles only
+	SeriesIDs    []int64              `json:"seriesIds,omitempty"`
+	SeriesID     int64                `json:"seriesId,omitempty"`
+	EpisodeIDs   []int64              `json:"episodeIds,omitempty"`
+	EpisodeID    int64                `json:"episodeId,omitempty"`
+	ManualImport []*ManualImportInput `json:"-"`
+	ImportMode   starr.ImportMode     `json:"importMode,omitempty"`
 }
 
 // CommandResponse comes from the /api/v3/command endpoint.
@@ -44,6 +60,20 @@ type CommandResponse struct {
 	Body                map[string]interface{} `json:"body"`
 }
 
+// MarshalJSON sends ManualImport as "files". RenameFiles uses the same key for Files.
+func (c CommandRequest) MarshalJSON() ([]byte, error) {
+	type command CommandRequest // avoid recursion.
+
+	if len(c.ManualImport) == 0 {
+		return json.Marshal((*command)(&c)) //nolint:wrapcheck
+	}
+
+	return json.Marshal(&struct { //nolint:wrapcheck
+		*command
+		Files []*ManualImportInput `json:"files"`
+	}{command: (*command)(&c), Files: c.ManualImport})
+}
+
 // GetCommands returns all available Sonarr commands.
 // These can be used with SendCommand.
 func (s *Sonarr) GetCommands() ([]*CommandResponse, error) {
@@ -109,3 +139,31 @@ func (s *Sonarr) GetCommandStatusContext(ctx context.Context, commandID int64) (
 
 	return &output, nil
 }
+
+// waitForCommand polls a command's status until Sonarr reports it is no longer queued or started.
+// The last status retrieved is returned.
+func (s *Sonarr) waitForCommand(ctx context.Context, cmd *CommandResponse, poll time.Duration) (*CommandResponse, error) {
+	if poll <= 0 {
+		poll = DefaultCommandPoll
+	}
+
+	ticker := time.NewTicker(poll)
+	defer ticker.Stop()
+
+	for cmd.ID != 0 && (cmd.Status == CommandQueued || cmd.Status == CommandStarted) {
+		select {
+		case <-ctx.Done():
+			return cmd, fmt.Errorf("waiting for %s command %d: %w", cmd.Name, cmd.ID, ctx.Err())
+		case <-ticker.C:
+		}
+
+		status, err := s.GetCommandStatusContext(ctx, cmd.ID)
+		if err != nil {
+			return cmd, err
+		}
+
+		cmd = status
+	}
+
+	return cmd, nil
+}
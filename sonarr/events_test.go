@@ -0,0 +1,59 @@
+package sonarr_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/craigjmidwinter/starr"
+	"github.com/craigjmidwinter/starr/sonarr"
+)
+
+func TestParseEvent(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		event    string
+		expected interface{}
+	}{
+		{
+			name:  "command",
+			event: `{"name":"command","body":{"action":"updated","resource":{"id":7,"name":"RefreshSeries"}}}`,
+			expected: &sonarr.CommandEvent{
+				Action:  starr.EventActionUpdated,
+				Command: &sonarr.CommandResponse{ID: 7, Name: "RefreshSeries"},
+			},
+		},
+		{
+			name:     "queue sync",
+			event:    `{"name":"queue","action":"sync","body":{"action":"sync"}}`,
+			expected: &sonarr.QueueEvent{Action: starr.EventActionSync},
+		},
+		{
+			name:     "queue status",
+			event:    `{"name":"queue/status","action":"updated","body":{"resource":{"totalCount":3,"errors":true}}}`,
+			expected: &sonarr.QueueStatusEvent{Action: starr.EventActionUpdated, Status: &sonarr.QueueStatus{TotalCount: 3, Errors: true}},
+		},
+		{
+			name:     "unknown",
+			event:    `{"name":"calendar","action":"updated"}`,
+			expected: &starr.Event{Name: "calendar", Action: starr.EventActionUpdated},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			var event starr.Event
+			require.NoError(t, json.Unmarshal([]byte(test.event), &event))
+
+			output, err := sonarr.ParseEvent(&event)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, output)
+		})
+	}
+}
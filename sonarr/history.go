@@ -74,28 +74,29 @@ func (s *Sonarr) GetHistory(records, perPage int) (*History, error) {
 
 func (s *Sonarr) GetHistoryContext(ctx context.Context, records, perPage int) (*History, error) {
 	hist := &History{Records: []*HistoryRecord{}}
-	perPage = starr.SetPerPage(records, perPage)
 
-	for page := 1; ; page++ {
-		curr, err := s.GetHistoryPageContext(ctx, &starr.PageReq{PageSize: perPage, Page: page})
-		if err != nil {
-			return nil, err
-		}
+	err := starr.Paginate(ctx, &starr.PageReq{PageSize: perPage}, records,
+		func(ctx context.Context, params *starr.PageReq) (int, int, error) {
+			curr, err := s.GetHistoryPageContext(ctx, params)
+			if err != nil {
+				return 0, 0, err
+			}
 
-		hist.Records = append(hist.Records, curr.Records...)
-
-		if len(hist.Records) >= curr.TotalRecords ||
-			(len(hist.Records) >= records && records != 0) ||
-			len(curr.Records) == 0 {
+			hist.Records = append(hist.Records, curr.Records...)
 			hist.PageSize = curr.TotalRecords
 			hist.TotalRecords = curr.TotalRecords
 			hist.SortDirection = curr.SortDirection
 			hist.SortKey = curr.SortKey
 
-			break
-		}
+			return len(curr.Records), curr.TotalRecords, nil
+		})
+	if err != nil {
+		return nil, err
+	}
 
-		perPage = starr.AdjustPerPage(records, curr.TotalRecords, len(hist.Records), perPage)
+	// The last page may return more records than requested.
+	if records > 0 && len(hist.Records) > records {
+		hist.Records = hist.Records[:records]
 	}
 
 	return hist, nil
@@ -0,0 +1,186 @@
+package sonarr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+
+	"github.com/craigjmidwinter/starr"
+)
+
+// Define Base Path for import list calls.
+const bpImportList = APIver + "/importlist"
+
+// ImportListInput is the input for a new or updated import list.
+type ImportListInput struct {
+	EnableAutomaticAdd bool                `json:"enableAutomaticAdd"`
+	SeasonFolder       bool                `json:"seasonFolder"`
+	ListOrder          int                 `json:"listOrder"`
+	ID                 int64               `json:"id,omitempty"`
+	QualityProfileID   int64               `json:"qualityProfileId,omitempty"`
+	LanguageProfileID  int64               `json:"languageProfileId,omitempty"` // v3 only
+	ShouldMonitor      string              `json:"shouldMonitor"`               // all, future, missing, existing, firstSeason, latestSeason, pilot, none
+	RootFolderPath     string              `json:"rootFolderPath"`
+	SeriesType         string              `json:"seriesType"` // standard, daily, anime
+	ConfigContract     string              `json:"configContract"`
+	Implementation     string              `json:"implementation"`
+	Name               string              `json:"name"`
+	ListType           string              `json:"listType,omitempty"`
+	Tags               []int               `json:"tags,omitempty"`
+	Fields             []*starr.FieldInput `json:"fields"`
+}
+
+// ImportListOutput is the output from the import list methods.
+type ImportListOutput struct {
+	EnableAutomaticAdd bool                 `json:"enableAutomaticAdd"`
+	SeasonFolder       bool                 `json:"seasonFolder"`
+	ListOrder          int                  `json:"listOrder"`
+	ID                 int64                `json:"id,omitempty"`
+	QualityProfileID   int64                `json:"qualityProfileId,omitempty"`
+	LanguageProfileID  int64                `json:"languageProfileId,omitempty"` // v3 only
+	ShouldMonitor      string               `json:"shouldMonitor"`
+	RootFolderPath     string               `json:"rootFolderPath"`
+	SeriesType         string               `json:"seriesType"`
+	ConfigContract     string               `json:"configContract"`
+	Implementation     string               `json:"implementation"`
+	ImplementationName string               `json:"implementationName"`
+	InfoLink           string               `json:"infoLink"`
+	ListType           string               `json:"listType"`
+	Name               string               `json:"name"`
+	Tags               []int                `json:"tags"`
+	Fields             []*starr.FieldOutput `json:"fields"`
+}
+
+// GetImportLists returns all configured import lists.
+func (s *Sonarr) GetImportLists() ([]*ImportListOutput, error) {
+	return s.GetImportListsContext(context.Background())
+}
+
+// GetImportListsContext returns all configured import lists.
+func (s *Sonarr) GetImportListsContext(ctx context.Context) ([]*ImportListOutput, error) {
+	var output []*ImportListOutput
+
+	req := starr.Request{URI: bpImportList}
+	if err := s.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// GetImportList returns a single import list.
+func (s *Sonarr) GetImportList(importListID int64) (*ImportListOutput, error) {
+	return s.GetImportListContext(context.Background(), importListID)
+}
+
+// GetImportListContext returns a single import list.
+func (s *Sonarr) GetImportListContext(ctx context.Context, importListID int64) (*ImportListOutput, error) {
+	var output ImportListOutput
+
+	req := starr.Request{URI: path.Join(bpImportList, fmt.Sprint(importListID))}
+	if err := s.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// GetImportListSchema returns the available import list types and their default settings.
+// Use one of these as a template for AddImportList().
+func (s *Sonarr) GetImportListSchema() ([]*ImportListOutput, error) {
+	return s.GetImportListSchemaContext(context.Background())
+}
+
+// GetImportListSchemaContext returns the available import list types and their default settings.
+func (s *Sonarr) GetImportListSchemaContext(ctx context.Context) ([]*ImportListOutput, error) {
+	var output []*ImportListOutput
+
+	req := starr.Request{URI: path.Join(bpImportList, "schema")}
+	if err := s.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// AddImportList creates an import list.
+func (s *Sonarr) AddImportList(importList *ImportListInput) (*ImportListOutput, error) {
+	return s.AddImportListContext(context.Background(), importList)
+}
+
+// AddImportListContext creates an import list.
+func (s *Sonarr) AddImportListContext(ctx context.Context, importList *ImportListInput) (*ImportListOutput, error) {
+	var output ImportListOutput
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(importList); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpImportList, err)
+	}
+
+	req := starr.Request{URI: bpImportList, Body: &body}
+	if err := s.PostInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// TestImportList asks Sonarr to validate an import list's settings without saving it.
+// A nil error means the test passed.
+func (s *Sonarr) TestImportList(importList *ImportListInput) error {
+	return s.TestImportListContext(context.Background(), importList)
+}
+
+// TestImportListContext asks Sonarr to validate an import list's settings without saving it.
+func (s *Sonarr) TestImportListContext(ctx context.Context, importList *ImportListInput) error {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(importList); err != nil {
+		return fmt.Errorf("json.Marshal(%s): %w", bpImportList, err)
+	}
+
+	req := starr.Request{URI: path.Join(bpImportList, "test"), Body: &body}
+	if err := s.PostAny(ctx, req); err != nil {
+		return fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+
+	return nil
+}
+
+// UpdateImportList updates the import list.
+func (s *Sonarr) UpdateImportList(importList *ImportListInput) (*ImportListOutput, error) {
+	return s.UpdateImportListContext(context.Background(), importList)
+}
+
+// UpdateImportListContext updates the import list.
+func (s *Sonarr) UpdateImportListContext(ctx context.Context, importList *ImportListInput) (*ImportListOutput, error) {
+	var output ImportListOutput
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(importList); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpImportList, err)
+	}
+
+	req := starr.Request{URI: path.Join(bpImportList, fmt.Sprint(importList.ID)), Body: &body}
+	if err := s.PutInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Put(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// DeleteImportList removes a single import list.
+func (s *Sonarr) DeleteImportList(importListID int64) error {
+	return s.DeleteImportListContext(context.Background(), importListID)
+}
+
+// DeleteImportListContext removes a single import list.
+func (s *Sonarr) DeleteImportListContext(ctx context.Context, importListID int64) error {
+	req := starr.Request{URI: path.Join(bpImportList, fmt.Sprint(importListID))}
+	if err := s.DeleteAny(ctx, req); err != nil {
+		return fmt.Errorf("api.Delete(%s): %w", &req, err)
+	}
+
+	return nil
+}
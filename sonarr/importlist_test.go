@@ -0,0 +1,226 @@
+package sonarr_test
+
+import (
+	"net/http"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/craigjmidwinter/starr"
+	"github.com/craigjmidwinter/starr/sonarr"
+)
+
+const importListResponseBody = `{
+	"enableAutomaticAdd": true,
+	"shouldMonitor": "all",
+	"rootFolderPath": "/tv",
+	"qualityProfileId": 1,
+	"languageProfileId": 1,
+	"seriesType": "standard",
+	"seasonFolder": true,
+	"listType": "trakt",
+	"listOrder": 1,
+	"name": "Watchlist",
+	"fields": [{"order": 0, "name": "username", "label": "Username", "value": "me", "type": "textbox"}],
+	"implementationName": "Trakt User",
+	"implementation": "TraktUserImport",
+	"configContract": "TraktUserSettings",
+	"infoLink": "https://wiki.servarr.com/sonarr/supported#traktuserimport",
+	"tags": [],
+	"id": 3
+}`
+
+const addImportList = `{"enableAutomaticAdd":true,"seasonFolder":true,"listOrder":0,"qualityProfileId":1,` +
+	`"shouldMonitor":"all","rootFolderPath":"/tv","seriesType":"standard","configContract":"TraktUserSettings",` +
+	`"implementation":"TraktUserImport","name":"Watchlist","fields":[{"name":"username","value":"me"}]}`
+
+func testImportListInput() *sonarr.ImportListInput {
+	return &sonarr.ImportListInput{
+		EnableAutomaticAdd: true,
+		SeasonFolder:       true,
+		QualityProfileID:   1,
+		ShouldMonitor:      "all",
+		RootFolderPath:     "/tv",
+		SeriesType:         "standard",
+		ConfigContract:     "TraktUserSettings",
+		Implementation:     "TraktUserImport",
+		Name:               "Watchlist",
+		Fields:             []*starr.FieldInput{{Name: "username", Value: "me"}},
+	}
+}
+
+var testImportListOutput = &sonarr.ImportListOutput{
+	EnableAutomaticAdd: true,
+	SeasonFolder:       true,
+	ListOrder:          1,
+	ID:                 3,
+	QualityProfileID:   1,
+	LanguageProfileID:  1,
+	ShouldMonitor:      "all",
+	RootFolderPath:     "/tv",
+	SeriesType:         "standard",
+	ConfigContract:     "TraktUserSettings",
+	Implementation:     "TraktUserImport",
+	ImplementationName: "Trakt User",
+	InfoLink:           "https://wiki.servarr.com/sonarr/supported#traktuserimport",
+	ListType:           "trakt",
+	Name:               "Watchlist",
+	Tags:               []int{},
+	Fields: []*starr.FieldOutput{
+		{Name: "username", Label: "Username", Value: "me", Type: "textbox"},
+	},
+}
+
+func TestGetImportList(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starr.TestMockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, sonarr.APIver, "importlist", "3"),
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusOK,
+			ResponseBody:   importListResponseBody,
+			WithRequest:    int64(3),
+			WithResponse:   testImportListOutput,
+			WithError:      nil,
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, sonarr.APIver, "importlist", "3"),
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusNotFound,
+			ResponseBody:   `{"message": "NotFound"}`,
+			WithRequest:    int64(3),
+			WithError:      starr.ErrInvalidStatusCode,
+			WithResponse:   (*sonarr.ImportListOutput)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := sonarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.GetImportList(test.WithRequest.(int64))
+			assert.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
+
+func TestAddImportList(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starr.TestMockData{
+		{
+			Name:            "200",
+			ExpectedPath:    path.Join("/", starr.API, sonarr.APIver, "importlist"),
+			ExpectedMethod:  http.MethodPost,
+			ResponseStatus:  http.StatusOK,
+			WithRequest:     testImportListInput(),
+			ExpectedRequest: addImportList + "\n",
+			ResponseBody:    importListResponseBody,
+			WithResponse:    testImportListOutput,
+			WithError:       nil,
+		},
+		{
+			Name:            "404",
+			ExpectedPath:    path.Join("/", starr.API, sonarr.APIver, "importlist"),
+			ExpectedMethod:  http.MethodPost,
+			ResponseStatus:  http.StatusNotFound,
+			WithRequest:     testImportListInput(),
+			ExpectedRequest: addImportList + "\n",
+			ResponseBody:    `{"message": "NotFound"}`,
+			WithError:       starr.ErrInvalidStatusCode,
+			WithResponse:    (*sonarr.ImportListOutput)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := sonarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.AddImportList(test.WithRequest.(*sonarr.ImportListInput))
+			assert.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
+
+func TestTestImportList(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starr.TestMockData{
+		{
+			Name:            "200",
+			ExpectedPath:    path.Join("/", starr.API, sonarr.APIver, "importlist", "test"),
+			ExpectedMethod:  http.MethodPost,
+			ResponseStatus:  http.StatusOK,
+			WithRequest:     testImportListInput(),
+			ExpectedRequest: addImportList + "\n",
+			ResponseBody:    "",
+			WithError:       nil,
+		},
+		{
+			Name:            "400",
+			ExpectedPath:    path.Join("/", starr.API, sonarr.APIver, "importlist", "test"),
+			ExpectedMethod:  http.MethodPost,
+			ResponseStatus:  http.StatusBadRequest,
+			WithRequest:     testImportListInput(),
+			ExpectedRequest: addImportList + "\n",
+			ResponseBody:    `[{"propertyName": "Username", "errorMessage": "Unable to find Trakt user"}]`,
+			WithError:       starr.ErrInvalidStatusCode,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := sonarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			err := client.TestImportList(test.WithRequest.(*sonarr.ImportListInput))
+			assert.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+		})
+	}
+}
+
+func TestDeleteImportList(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starr.TestMockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, sonarr.APIver, "importlist", "3"),
+			ExpectedMethod: http.MethodDelete,
+			WithRequest:    int64(3),
+			ResponseStatus: http.StatusOK,
+			ResponseBody:   "{}",
+			WithError:      nil,
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, sonarr.APIver, "importlist", "3"),
+			ExpectedMethod: http.MethodDelete,
+			WithRequest:    int64(3),
+			ResponseStatus: http.StatusNotFound,
+			ResponseBody:   `{"message": "NotFound"}`,
+			WithError:      starr.ErrInvalidStatusCode,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := sonarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			err := client.DeleteImportList(test.WithRequest.(int64))
+			assert.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+		})
+	}
+}
@@ -0,0 +1,152 @@
+package sonarr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+
+	"github.com/craigjmidwinter/starr"
+)
+
+// Define Base Path for import list exclusion calls.
+const bpImportListExclusion = APIver + "/importlistexclusion"
+
+// ImportListExclusion is a series that import lists will never add to Sonarr.
+type ImportListExclusion struct {
+	ID     int64  `json:"id,omitempty"`
+	TvdbID int64  `json:"tvdbId"`
+	Title  string `json:"title"`
+}
+
+// GetImportListExclusions returns all import list exclusions.
+func (s *Sonarr) GetImportListExclusions() ([]*ImportListExclusion, error) {
+	return s.GetImportListExclusionsContext(context.Background())
+}
+
+// GetImportListExclusionsContext returns all import list exclusions.
+func (s *Sonarr) GetImportListExclusionsContext(ctx context.Context) ([]*ImportListExclusion, error) {
+	var output []*ImportListExclusion
+
+	req := starr.Request{URI: bpImportListExclusion}
+	if err := s.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// GetImportListExclusion returns a single import list exclusion.
+func (s *Sonarr) GetImportListExclusion(exclusionID int64) (*ImportListExclusion, error) {
+	return s.GetImportListExclusionContext(context.Background(), exclusionID)
+}
+
+// GetImportListExclusionContext returns a single import list exclusion.
+func (s *Sonarr) GetImportListExclusionContext(ctx context.Context, exclusionID int64) (*ImportListExclusion, error) {
+	var output ImportListExclusion
+
+	req := starr.Request{URI: path.Join(bpImportListExclusion, fmt.Sprint(exclusionID))}
+	if err := s.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// GetImportListExclusionByTvdbID finds an import list exclusion by the series' TVDb ID.
+// Returns nil if the series is not excluded.
+func (s *Sonarr) GetImportListExclusionByTvdbID(tvdbID int64) (*ImportListExclusion, error) {
+	return s.GetImportListExclusionByTvdbIDContext(context.Background(), tvdbID)
+}
+
+// GetImportListExclusionByTvdbIDContext finds an import list exclusion by the series' TVDb ID.
+// Returns nil if the series is not excluded.
+func (s *Sonarr) GetImportListExclusionByTvdbIDContext(
+	ctx context.Context,
+	tvdbID int64,
+) (*ImportListExclusion, error) {
+	exclusions, err := s.GetImportListExclusionsContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var found *ImportListExclusion
+
+	for _, exclusion := range exclusions {
+		if exclusion.TvdbID == tvdbID {
+			found = exclusion
+			break
+		}
+	}
+
+	return found, nil
+}
+
+// AddImportListExclusion creates an import list exclusion.
+func (s *Sonarr) AddImportListExclusion(exclusion *ImportListExclusion) (*ImportListExclusion, error) {
+	return s.AddImportListExclusionContext(context.Background(), exclusion)
+}
+
+// AddImportListExclusionContext creates an import list exclusion.
+func (s *Sonarr) AddImportListExclusionContext(
+	ctx context.Context,
+	exclusion *ImportListExclusion,
+) (*ImportListExclusion, error) {
+	exclusion.ID = 0
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(exclusion); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpImportListExclusion, err)
+	}
+
+	var output ImportListExclusion
+
+	req := starr.Request{URI: bpImportListExclusion, Body: &body}
+	if err := s.PostInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// UpdateImportListExclusion updates an import list exclusion.
+func (s *Sonarr) UpdateImportListExclusion(exclusion *ImportListExclusion) (*ImportListExclusion, error) {
+	return s.UpdateImportListExclusionContext(context.Background(), exclusion)
+}
+
+// UpdateImportListExclusionContext updates an import list exclusion.
+func (s *Sonarr) UpdateImportListExclusionContext(
+	ctx context.Context,
+	exclusion *ImportListExclusion,
+) (*ImportListExclusion, error) {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(exclusion); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpImportListExclusion, err)
+	}
+
+	var output ImportListExclusion
+
+	req := starr.Request{URI: path.Join(bpImportListExclusion, fmt.Sprint(exclusion.ID)), Body: &body}
+	if err := s.PutInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Put(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// DeleteImportListExclusion removes a single import list exclusion.
+// Use GetImportListExclusionByTvdbID to find the exclusion ID for a series.
+func (s *Sonarr) DeleteImportListExclusion(exclusionID int64) error {
+	return s.DeleteImportListExclusionContext(context.Background(), exclusionID)
+}
+
+// DeleteImportListExclusionContext removes a single import list exclusion.
+func (s *Sonarr) DeleteImportListExclusionContext(ctx context.Context, exclusionID int64) error {
+	req := starr.Request{URI: path.Join(bpImportListExclusion, fmt.Sprint(exclusionID))}
+	if err := s.DeleteAny(ctx, req); err != nil {
+		return fmt.Errorf("api.Delete(%s): %w", &req, err)
+	}
+
+	return nil
+}
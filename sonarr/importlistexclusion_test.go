@@ -0,0 +1,140 @@
+package sonarr_test
+
+import (
+	"net/http"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/craigjmidwinter/starr"
+	"github.com/craigjmidwinter/starr/sonarr"
+)
+
+const importListExclusionsBody = `[{"tvdbId":81189,"title":"Breaking Bad","id":1},` +
+	`{"tvdbId":121361,"title":"Game of Thrones","id":2}]`
+
+func TestGetImportListExclusionByTvdbID(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starr.TestMockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, sonarr.APIver, "importlistexclusion"),
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusOK,
+			ResponseBody:   importListExclusionsBody,
+			WithRequest:    int64(121361),
+			WithResponse:   &sonarr.ImportListExclusion{ID: 2, TvdbID: 121361, Title: "Game of Thrones"},
+			WithError:      nil,
+		},
+		{
+			Name:           "not excluded",
+			ExpectedPath:   path.Join("/", starr.API, sonarr.APIver, "importlistexclusion"),
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusOK,
+			ResponseBody:   importListExclusionsBody,
+			WithRequest:    int64(5),
+			WithResponse:   (*sonarr.ImportListExclusion)(nil),
+			WithError:      nil,
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, sonarr.APIver, "importlistexclusion"),
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusNotFound,
+			ResponseBody:   `{"message": "NotFound"}`,
+			WithRequest:    int64(121361),
+			WithResponse:   (*sonarr.ImportListExclusion)(nil),
+			WithError:      starr.ErrInvalidStatusCode,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := sonarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.GetImportListExclusionByTvdbID(test.WithRequest.(int64))
+			assert.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
+
+func TestAddImportListExclusion(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starr.TestMockData{
+		{
+			Name:            "200",
+			ExpectedPath:    path.Join("/", starr.API, sonarr.APIver, "importlistexclusion"),
+			ExpectedMethod:  http.MethodPost,
+			ResponseStatus:  http.StatusOK,
+			WithRequest:     &sonarr.ImportListExclusion{ID: 9, TvdbID: 81189, Title: "Breaking Bad"},
+			ExpectedRequest: `{"tvdbId":81189,"title":"Breaking Bad"}` + "\n",
+			ResponseBody:    `{"tvdbId":81189,"title":"Breaking Bad","id":1}`,
+			WithResponse:    &sonarr.ImportListExclusion{ID: 1, TvdbID: 81189, Title: "Breaking Bad"},
+			WithError:       nil,
+		},
+		{
+			Name:            "400",
+			ExpectedPath:    path.Join("/", starr.API, sonarr.APIver, "importlistexclusion"),
+			ExpectedMethod:  http.MethodPost,
+			ResponseStatus:  http.StatusBadRequest,
+			WithRequest:     &sonarr.ImportListExclusion{TvdbID: 81189, Title: "Breaking Bad"},
+			ExpectedRequest: `{"tvdbId":81189,"title":"Breaking Bad"}` + "\n",
+			ResponseBody:    `[{"propertyName": "TvdbId", "errorMessage": "This series has already been excluded."}]`,
+			WithResponse:    (*sonarr.ImportListExclusion)(nil),
+			WithError:       starr.ErrInvalidStatusCode,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := sonarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.AddImportListExclusion(test.WithRequest.(*sonarr.ImportListExclusion))
+			assert.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
+
+func TestDeleteImportListExclusion(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starr.TestMockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, sonarr.APIver, "importlistexclusion", "2"),
+			ExpectedMethod: http.MethodDelete,
+			WithRequest:    int64(2),
+			ResponseStatus: http.StatusOK,
+			ResponseBody:   "{}",
+			WithError:      nil,
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, sonarr.APIver, "importlistexclusion", "2"),
+			ExpectedMethod: http.MethodDelete,
+			WithRequest:    int64(2),
+			ResponseStatus: http.StatusNotFound,
+			ResponseBody:   `{"message": "NotFound"}`,
+			WithError:      starr.ErrInvalidStatusCode,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := sonarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			err := client.DeleteImportListExclusion(test.WithRequest.(int64))
+			assert.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package sonarr
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/craigjmidwinter/starr"
+)
+
+const bpManualImport = APIver + "/manualimport"
+
+// ManualImportInput is a file to import with the ManualImport command.
+// Build these from the output of GetManualImport, after choosing the correct series and episodes.
+type ManualImportInput struct {
+	Path         string         `json:"path"`
+	FolderName   string         `json:"folderName,omitempty"`
+	SeriesID     int64          `json:"seriesId"`
+	EpisodeIDs   []int64        `json:"episodeIds"`
+	Quality      *starr.Quality `json:"quality,omitempty"`
+	Language     *starr.Value   `json:"language,omitempty"`  // v3 only.
+	Languages    []*starr.Value `json:"languages,omitempty"` // v4 only.
+	ReleaseGroup string         `json:"releaseGroup,omitempty"`
+	DownloadID   string         `json:"downloadId,omitempty"`
+}
+
+// ManualImportOutput is a candidate file returned from the /api/v3/manualimport endpoint.
+// Series is nil when Sonarr could not identify the file; Rejections explain why it will not import on its own.
+type ManualImportOutput struct {
+	ID                int64           `json:"id"`
+	Path              string          `json:"path"`
+	RelativePath      string          `json:"relativePath"`
+	FolderName        string          `json:"folderName"`
+	Name              string          `json:"name"`
+	Size              int64           `json:"size"`
+	Series            *Series         `json:"series"`
+	SeasonNumber      int64           `json:"seasonNumber"`
+	Episodes          []*Episode      `json:"episodes"`
+	Quality           *starr.Quality  `json:"quality"`
+	Language          *starr.Value    `json:"language,omitempty"`  // v3 only.
+	Languages         []*starr.Value  `json:"languages,omitempty"` // v4 only.
+	ReleaseGroup      string          `json:"releaseGroup"`
+	QualityWeight     int64           `json:"qualityWeight"`
+	DownloadID        string          `json:"downloadId"`
+	CustomFormats     []*CustomFormat `json:"customFormats,omitempty"` // v4 only.
+	CustomFormatScore int64           `json:"customFormatScore"`       // v4 only.
+	Rejections        []*Rejection    `json:"rejections"`
+}
+
+// Rejection is a reason a file will not be imported.
+type Rejection struct {
+	Reason string `json:"reason"`
+	Type   string `json:"type"` // permanent or temporary
+}
+
+// GetManualImport scans a folder, or the output path of a download client item, and returns
+// the files that may be imported. Provide a folder or a downloadID. When seriesID is not zero,
+// files are matched to that series instead of parsing it from the file name.
+// Send the files to the ManualImport command with SendCommand() to import them.
+func (s *Sonarr) GetManualImport(
+	folder, downloadID string,
+	seriesID int64,
+	filterExistingFiles bool,
+) ([]*ManualImportOutput, error) {
+	return s.GetManualImportContext(context.Background(), folder, downloadID, seriesID, filterExistingFiles)
+}
+
+// GetManualImportContext scans a folder, or the output path of a download client item, and returns
+// the files that may be imported.
+func (s *Sonarr) GetManualImportContext(
+	ctx context.Context,
+	folder, downloadID string,
+	seriesID int64,
+	filterExistingFiles bool,
+) ([]*ManualImportOutput, error) {
+	var output []*ManualImportOutput
+
+	req := starr.Request{URI: bpManualImport, Query: make(url.Values)}
+	req.Query.Add("filterExistingFiles", fmt.Sprint(filterExistingFiles))
+
+	if folder != "" {
+		req.Query.Add("folder", folder)
+	}
+
+	if downloadID != "" {
+		req.Query.Add("downloadId", downloadID)
+	}
+
+	if seriesID != 0 {
+		req.Query.Add("seriesId", fmt.Sprint(seriesID))
+	}
+
+	if err := s.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// ManualImportInput returns the input needed to import this file with the ManualImport command.
+// The file must be matched to a series and episodes; set Series and Episodes
+// before calling this if Sonarr did not identify them.
+func (m *ManualImportOutput) ManualImportInput() *ManualImportInput {
+	input := &ManualImportInput{
+		Path:         m.Path,
+		FolderName:   m.FolderName,
+		EpisodeIDs:   make([]int64, len(m.Episodes)),
+		Quality:      m.Quality,
+		Language:     m.Language,
+		Languages:    m.Languages,
+		ReleaseGroup: m.ReleaseGroup,
+		DownloadID:   m.DownloadID,
+	}
+
+	if m.Series != nil {
+		input.SeriesID = m.Series.ID
+	}
+
+	for idx, episode := range m.Episodes {
+		input.EpisodeIDs[idx] = episode.ID
+	}
+
+	return input
+}
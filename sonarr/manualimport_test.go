@@ -0,0 +1,117 @@
+package sonarr_test
+
+import (
+	"net/http"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/craigjmidwinter/starr"
+	"github.com/craigjmidwinter/starr/sonarr"
+)
+
+const testManualImportJSON = `{
+	"id": 1,
+	"path": "/downloads/Some.Show.S01E02.1080p/show.mkv",
+	"relativePath": "show.mkv",
+	"folderName": "Some.Show.S01E02.1080p",
+	"name": "show",
+	"size": 1000,
+	"seasonNumber": 1,
+	"episodes": [{"id": 12, "seriesId": 3, "seasonNumber": 1, "episodeNumber": 2}],
+	"quality": {"quality": {"id": 7, "name": "Bluray-1080p"}},
+	"language": {"id": 1, "name": "English"},
+	"releaseGroup": "GRP",
+	"downloadId": "ABCD",
+	"rejections": [{"reason": "Unknown Series", "type": "permanent"}]
+}`
+
+func TestGetManualImport(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starr.TestMockData{
+		{
+			Name: "200",
+			ExpectedPath: path.Join("/", starr.API, sonarr.APIver, "manualimport") +
+				"?downloadId=ABCD&filterExistingFiles=true",
+			ResponseStatus: http.StatusOK,
+			ResponseBody:   `[` + testManualImportJSON + `]`,
+			WithRequest:    []interface{}{"", "ABCD", int64(0), true},
+			WithError:      nil,
+			ExpectedMethod: http.MethodGet,
+			WithResponse: []*sonarr.ManualImportOutput{{
+				ID:           1,
+				Path:         "/downloads/Some.Show.S01E02.1080p/show.mkv",
+				RelativePath: "show.mkv",
+				FolderName:   "Some.Show.S01E02.1080p",
+				Name:         "show",
+				Size:         1000,
+				SeasonNumber: 1,
+				Episodes:     []*sonarr.Episode{{ID: 12, SeriesID: 3, SeasonNumber: 1, EpisodeNumber: 2}},
+				Quality:      &starr.Quality{Quality: &starr.BaseQuality{ID: 7, Name: "Bluray-1080p"}},
+				Language:     &starr.Value{ID: 1, Name: "English"},
+				ReleaseGroup: "GRP",
+				DownloadID:   "ABCD",
+				Rejections:   []*sonarr.Rejection{{Reason: "Unknown Series", Type: "permanent"}},
+			}},
+		},
+		{
+			Name: "404",
+			ExpectedPath: path.Join("/", starr.API, sonarr.APIver, "manualimport") +
+				"?filterExistingFiles=false&folder=%2Fdownloads&seriesId=3",
+			ResponseStatus: http.StatusNotFound,
+			ResponseBody:   `{"message": "NotFound"}`,
+			WithRequest:    []interface{}{"/downloads", "", int64(3), false},
+			WithError:      starr.ErrInvalidStatusCode,
+			ExpectedMethod: http.MethodGet,
+			WithResponse:   []*sonarr.ManualImportOutput(nil),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := sonarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			input := test.WithRequest.([]interface{})
+			output, err := client.GetManualImport(input[0].(string), input[1].(string), input[2].(int64), input[3].(bool))
+			assert.ErrorIs(t, err, test.WithError, "the wrong error was returned")
+			assert.EqualValues(t, test.WithResponse, output, "make sure ResponseBody and WithResponse are a match")
+		})
+	}
+}
+
+func TestManualImportCommand(t *testing.T) {
+	t.Parallel()
+
+	file := &sonarr.ManualImportOutput{
+		Path:       "/downloads/show.mkv",
+		Series:     &sonarr.Series{ID: 3},
+		Episodes:   []*sonarr.Episode{{ID: 12}, {ID: 13}},
+		Quality:    &starr.Quality{Quality: &starr.BaseQuality{ID: 7}},
+		DownloadID: "ABCD",
+	}
+
+	test := &starr.TestMockData{
+		Name:           "200",
+		ExpectedPath:   path.Join("/", starr.API, sonarr.APIver, "command"),
+		ResponseStatus: http.StatusCreated,
+		ResponseBody:   `{"id":99,"name":"ManualImport","status":"queued"}`,
+		ExpectedRequest: `{"name":"ManualImport","importMode":"copy","files":[{"path":"/downloads/show.mkv",` +
+			`"seriesId":3,"episodeIds":[12,13],"quality":{"quality":{"id":7,"name":""},"allowed":false},` +
+			`"downloadId":"ABCD"}]}` + "\n",
+		ExpectedMethod: http.MethodPost,
+		WithResponse:   &sonarr.CommandResponse{ID: 99, Name: "ManualImport", Status: "queued"},
+	}
+
+	mockServer := test.GetMockServer(t)
+	client := sonarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+	output, err := client.SendCommand(&sonarr.CommandRequest{
+		Name:         "ManualImport",
+		ManualImport: []*sonarr.ManualImportInput{file.ManualImportInput()},
+		ImportMode:   starr.ImportModeCopy,
+	})
+	assert.NoError(t, err)
+	assert.EqualValues(t, test.WithResponse, output)
+}
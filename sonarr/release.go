@@ -0,0 +1,179 @@
+package sonarr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"path"
+	"time"
+
+	"github.com/craigjmidwinter/starr"
+)
+
+const bpRelease = APIver + "/release"
+
+// Release is the output from the /api/v3/release endpoint.
+// These are the releases found when searching indexers for an episode or season (interactive search).
+type Release struct {
+	ID                           int64           `json:"id,omitempty"`
+	GUID                         string          `json:"guid"`
+	Quality                      *starr.Quality  `json:"quality"`
+	QualityWeight                int64           `json:"qualityWeight"`
+	Age                          int64           `json:"age"`
+	AgeHours                     float64         `json:"ageHours"`
+	AgeMinutes                   float64         `json:"ageMinutes"`
+	Size                         int64           `json:"size"`
+	IndexerID                    int64           `json:"indexerId"`
+	Indexer                      string          `json:"indexer"`
+	ReleaseGroup                 string          `json:"releaseGroup,omitempty"`
+	SubGroup                     string          `json:"subGroup,omitempty"`
+	ReleaseHash                  string          `json:"releaseHash,omitempty"`
+	Title                        string          `json:"title"`
+	FullSeason                   bool            `json:"fullSeason"`
+	SceneSource                  bool            `json:"sceneSource"`
+	SeasonNumber                 int64           `json:"seasonNumber"`
+	Language                     *starr.Value    `json:"language,omitempty"`  // v3 only.
+	Languages                    []*starr.Value  `json:"languages,omitempty"` // v4 only.
+	LanguageWeight               int64           `json:"languageWeight,omitempty"`
+	AirDate                      string          `json:"airDate,omitempty"`
+	SeriesTitle                  string          `json:"seriesTitle"`
+	EpisodeNumbers               []int64         `json:"episodeNumbers,omitempty"`
+	AbsoluteEpisodeNumbers       []int64         `json:"absoluteEpisodeNumbers,omitempty"`
+	MappedSeasonNumber           int64           `json:"mappedSeasonNumber,omitempty"`
+	MappedEpisodeNumbers         []int64         `json:"mappedEpisodeNumbers,omitempty"`
+	MappedAbsoluteEpisodeNumbers []int64         `json:"mappedAbsoluteEpisodeNumbers,omitempty"`
+	Approved                     bool            `json:"approved"`
+	TemporarilyRejected          bool            `json:"temporarilyRejected"`
+	Rejected                     bool            `json:"rejected"`
+	TvdbID                       int64           `json:"tvdbId,omitempty"`
+	TvRageID                     int64           `json:"tvRageId,omitempty"`
+	Rejections                   []string        `json:"rejections,omitempty"`
+	PublishDate                  time.Time       `json:"publishDate"`
+	CommentURL                   string          `json:"commentUrl,omitempty"`
+	DownloadURL                  string          `json:"downloadUrl,omitempty"`
+	InfoURL                      string          `json:"infoUrl,omitempty"`
+	EpisodeRequested             bool            `json:"episodeRequested"`
+	DownloadAllowed              bool            `json:"downloadAllowed"`
+	ReleaseWeight                int64           `json:"releaseWeight"`
+	PreferredWordScore           int64           `json:"preferredWordScore,omitempty"` // v3 only.
+	CustomFormats                []*CustomFormat `json:"customFormats,omitempty"`      // v4 only.
+	CustomFormatScore            int64           `json:"customFormatScore,omitempty"`  // v4 only.
+	MagnetURL                    string          `json:"magnetUrl,omitempty"`
+	InfoHash                     string          `json:"infoHash,omitempty"`
+	Seeders                      int64           `json:"seeders,omitempty"`
+	Leechers                     int64           `json:"leechers,omitempty"`
+	Protocol                     string          `json:"protocol"`
+	IsDaily                      bool            `json:"isDaily"`
+	IsAbsoluteNumbering          bool            `json:"isAbsoluteNumbering"`
+	IsPossibleSpecialEpisode     bool            `json:"isPossibleSpecialEpisode"`
+	Special                      bool            `json:"special"`
+	SeriesID                     int64           `json:"seriesId,omitempty"`
+	EpisodeID                    int64           `json:"episodeId,omitempty"`
+}
+
+// PushRelease is the input for pushing a release to Sonarr with PushRelease().
+// Sonarr parses the title, and if it matches a wanted episode, sends it to a download client.
+type PushRelease struct {
+	Title            string    `json:"title"`
+	DownloadURL      string    `json:"downloadUrl,omitempty"`
+	MagnetURL        string    `json:"magnetUrl,omitempty"`
+	Protocol         string    `json:"protocol"`
+	PublishDate      time.Time `json:"publishDate"`
+	Indexer          string    `json:"indexer,omitempty"`
+	IndexerID        int64     `json:"indexerId,omitempty"`
+	Size             int64     `json:"size,omitempty"`
+	DownloadClientID int64     `json:"downloadClientId,omitempty"`
+	DownloadClient   string    `json:"downloadClient,omitempty"`
+}
+
+// SearchRelease searches all enabled indexers for an episode and returns every release found.
+// Releases are not grabbed; use GrabRelease to download one. This may take a while.
+func (s *Sonarr) SearchRelease(episodeID int64) ([]*Release, error) {
+	return s.SearchReleaseContext(context.Background(), episodeID)
+}
+
+// SearchReleaseContext searches all enabled indexers for an episode and returns every release found.
+func (s *Sonarr) SearchReleaseContext(ctx context.Context, episodeID int64) ([]*Release, error) {
+	req := starr.Request{URI: bpRelease, Query: make(url.Values)}
+	req.Query.Add("episodeId", fmt.Sprint(episodeID))
+
+	return s.searchRelease(ctx, req)
+}
+
+// SearchSeasonRelease searches all enabled indexers for a season of a series and returns every release found.
+// Check FullSeason on each release to tell season packs apart from single episodes.
+// Releases are not grabbed; use GrabRelease to download one. This may take a while.
+func (s *Sonarr) SearchSeasonRelease(seriesID, seasonNumber int64) ([]*Release, error) {
+	return s.SearchSeasonReleaseContext(context.Background(), seriesID, seasonNumber)
+}
+
+// SearchSeasonReleaseContext searches all enabled indexers for a season of a series and returns every release found.
+func (s *Sonarr) SearchSeasonReleaseContext(ctx context.Context, seriesID, seasonNumber int64) ([]*Release, error) {
+	req := starr.Request{URI: bpRelease, Query: make(url.Values)}
+	req.Query.Add("seriesId", fmt.Sprint(seriesID))
+	req.Query.Add("seasonNumber", fmt.Sprint(seasonNumber))
+
+	return s.searchRelease(ctx, req)
+}
+
+func (s *Sonarr) searchRelease(ctx context.Context, req starr.Request) ([]*Release, error) {
+	var output []*Release
+
+	if err := s.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// GrabRelease sends a release found with SearchRelease() or SearchSeasonRelease() to a download client.
+// Only the GUID and IndexerID are required, and the release must have been
+// returned by a recent search, because Sonarr looks it up in its release cache.
+func (s *Sonarr) GrabRelease(release *Release) (*Release, error) {
+	return s.GrabReleaseContext(context.Background(), release)
+}
+
+// GrabReleaseContext sends a release found with SearchRelease() or SearchSeasonRelease() to a download client.
+func (s *Sonarr) GrabReleaseContext(ctx context.Context, release *Release) (*Release, error) {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(&struct {
+		GUID      string `json:"guid"`
+		IndexerID int64  `json:"indexerId"`
+	}{GUID: release.GUID, IndexerID: release.IndexerID}); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpRelease, err)
+	}
+
+	var output Release
+
+	req := starr.Request{URI: bpRelease, Body: &body}
+	if err := s.PostInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// PushRelease sends a release from an outside source to Sonarr.
+// The returned releases show if it was approved, and why it was rejected if it was not.
+func (s *Sonarr) PushRelease(release *PushRelease) ([]*Release, error) {
+	return s.PushReleaseContext(context.Background(), release)
+}
+
+// PushReleaseContext sends a release from an outside source to Sonarr.
+func (s *Sonarr) PushReleaseContext(ctx context.Context, release *PushRelease) ([]*Release, error) {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(release); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpRelease, err)
+	}
+
+	var output []*Release
+
+	req := starr.Request{URI: path.Join(bpRelease, "push"), Body: &body}
+	if err := s.PostInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
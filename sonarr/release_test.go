@@ -0,0 +1,191 @@
+package sonarr_test
+
+import (
+	"net/http"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/craigjmidwinter/starr"
+	"github.com/craigjmidwinter/starr/sonarr"
+)
+
+func TestSearchRelease(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starr.TestMockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, sonarr.APIver, "release") + "?episodeId=17",
+			ResponseStatus: http.StatusOK,
+			ResponseBody: `[{"guid":"abc","title":"Some.Show.S01E01.1080p","indexerId":3,"indexer":"Tracker",` +
+				`"size":1000,"seeders":12,"approved":false,"rejections":["Not an upgrade"],"customFormatScore":50,` +
+				`"quality":{"quality":{"id":7,"name":"Bluray-1080p"}},"protocol":"torrent"}]`,
+			WithRequest:    int64(17),
+			WithError:      nil,
+			ExpectedMethod: http.MethodGet,
+			WithResponse: []*sonarr.Release{{
+				GUID:              "abc",
+				Title:             "Some.Show.S01E01.1080p",
+				IndexerID:         3,
+				Indexer:           "Tracker",
+				Size:              1000,
+				Seeders:           12,
+				Rejections:        []string{"Not an upgrade"},
+				CustomFormatScore: 50,
+				Quality:           &starr.Quality{Quality: &starr.BaseQuality{ID: 7, Name: "Bluray-1080p"}},
+				Protocol:          "torrent",
+			}},
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, sonarr.APIver, "release") + "?episodeId=17",
+			ResponseStatus: http.StatusNotFound,
+			ResponseBody:   `{"message": "NotFound"}`,
+			WithRequest:    int64(17),
+			WithError:      starr.ErrInvalidStatusCode,
+			ExpectedMethod: http.MethodGet,
+			WithResponse:   []*sonarr.Release(nil),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := sonarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.SearchRelease(test.WithRequest.(int64))
+			assert.ErrorIs(t, err, test.WithError, "the wrong error was returned")
+			assert.EqualValues(t, test.WithResponse, output, "make sure ResponseBody and WithResponse are a match")
+		})
+	}
+}
+
+func TestSearchSeasonRelease(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starr.TestMockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, sonarr.APIver, "release") + "?seasonNumber=2&seriesId=9",
+			ResponseStatus: http.StatusOK,
+			ResponseBody: `[{"guid":"def","title":"Some.Show.S02.1080p","fullSeason":true,"seasonNumber":2,` +
+				`"indexerId":3,"rejected":true,"rejections":["Unknown Series"],"protocol":"usenet"}]`,
+			WithRequest:    []int64{9, 2},
+			WithError:      nil,
+			ExpectedMethod: http.MethodGet,
+			WithResponse: []*sonarr.Release{{
+				GUID:         "def",
+				Title:        "Some.Show.S02.1080p",
+				FullSeason:   true,
+				SeasonNumber: 2,
+				IndexerID:    3,
+				Rejected:     true,
+				Rejections:   []string{"Unknown Series"},
+				Protocol:     "usenet",
+			}},
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, sonarr.APIver, "release") + "?seasonNumber=2&seriesId=9",
+			ResponseStatus: http.StatusNotFound,
+			ResponseBody:   `{"message": "NotFound"}`,
+			WithRequest:    []int64{9, 2},
+			WithError:      starr.ErrInvalidStatusCode,
+			ExpectedMethod: http.MethodGet,
+			WithResponse:   []*sonarr.Release(nil),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := sonarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			input := test.WithRequest.([]int64)
+			output, err := client.SearchSeasonRelease(input[0], input[1])
+			assert.ErrorIs(t, err, test.WithError, "the wrong error was returned")
+			assert.EqualValues(t, test.WithResponse, output, "make sure ResponseBody and WithResponse are a match")
+		})
+	}
+}
+
+func TestGrabRelease(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starr.TestMockData{
+		{
+			Name:            "200",
+			ExpectedPath:    path.Join("/", starr.API, sonarr.APIver, "release"),
+			ResponseStatus:  http.StatusOK,
+			ResponseBody:    `{"guid":"abc","indexerId":3,"approved":true}`,
+			WithRequest:     &sonarr.Release{GUID: "abc", IndexerID: 3, Title: "ignored"},
+			ExpectedRequest: `{"guid":"abc","indexerId":3}` + "\n",
+			WithError:       nil,
+			ExpectedMethod:  http.MethodPost,
+			WithResponse:    &sonarr.Release{GUID: "abc", IndexerID: 3, Approved: true},
+		},
+		{
+			Name:            "404",
+			ExpectedPath:    path.Join("/", starr.API, sonarr.APIver, "release"),
+			ResponseStatus:  http.StatusNotFound,
+			ResponseBody:    `{"message": "NotFound"}`,
+			WithRequest:     &sonarr.Release{GUID: "abc", IndexerID: 3},
+			ExpectedRequest: `{"guid":"abc","indexerId":3}` + "\n",
+			WithError:       starr.ErrInvalidStatusCode,
+			ExpectedMethod:  http.MethodPost,
+			WithResponse:    (*sonarr.Release)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := sonarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.GrabRelease(test.WithRequest.(*sonarr.Release))
+			assert.ErrorIs(t, err, test.WithError, "the wrong error was returned")
+			assert.EqualValues(t, test.WithResponse, output, "make sure ResponseBody and WithResponse are a match")
+		})
+	}
+}
+
+func TestPushRelease(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starr.TestMockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, sonarr.APIver, "release", "push"),
+			ResponseStatus: http.StatusOK,
+			ResponseBody:   `[{"title":"Some.Show.S01E01.1080p","approved":true,"downloadAllowed":true}]`,
+			WithRequest: &sonarr.PushRelease{
+				Title:       "Some.Show.S01E01.1080p",
+				DownloadURL: "http://x/y.torrent",
+				Protocol:    "torrent",
+				PublishDate: time.Date(2022, 1, 2, 3, 4, 5, 0, time.UTC),
+			},
+			ExpectedRequest: `{"title":"Some.Show.S01E01.1080p","downloadUrl":"http://x/y.torrent",` +
+				`"protocol":"torrent","publishDate":"2022-01-02T03:04:05Z"}` + "\n",
+			WithError:      nil,
+			ExpectedMethod: http.MethodPost,
+			WithResponse:   []*sonarr.Release{{Title: "Some.Show.S01E01.1080p", Approved: true, DownloadAllowed: true}},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := sonarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.PushRelease(test.WithRequest.(*sonarr.PushRelease))
+			assert.ErrorIs(t, err, test.WithError, "the wrong error was returned")
+			assert.EqualValues(t, test.WithResponse, output, "make sure ResponseBody and WithResponse are a match")
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package sonarr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/craigjmidwinter/starr"
+)
+
+const bpSeriesEditor = bpSeries + "/editor"
+
+// BulkEdit is the input for the bulk series editor endpoint.
+// You may use starr.True(), starr.False(), starr.Int64(), and starr.String() to add data to the struct members.
+// Use starr.ApplyTags.Ptr() for apply tags.
+type BulkEdit struct {
+	SeriesIDs              []int64          `json:"seriesIds"`
+	Monitored              *bool            `json:"monitored,omitempty"`
+	QualityProfileID       *int64           `json:"qualityProfileId,omitempty"`
+	LanguageProfileID      *int64           `json:"languageProfileId,omitempty"` // v3 only
+	SeriesType             *string          `json:"seriesType,omitempty"`        // standard, daily, anime
+	SeasonFolder           *bool            `json:"seasonFolder,omitempty"`
+	RootFolderPath         *string          `json:"rootFolderPath,omitempty"` // path
+	Tags                   []int            `json:"tags,omitempty"`           // [0]
+	ApplyTags              *starr.ApplyTags `json:"applyTags,omitempty"`      // add
+	MoveFiles              *bool            `json:"moveFiles,omitempty"`
+	DeleteFiles            *bool            `json:"deleteFiles,omitempty"`            // delete only
+	AddImportListExclusion *bool            `json:"addImportListExclusion,omitempty"` // delete only
+}
+
+// EditSeries allows bulk editing many series at once.
+func (s *Sonarr) EditSeries(editSeries *BulkEdit) ([]*Series, error) {
+	return s.EditSeriesContext(context.Background(), editSeries)
+}
+
+// EditSeriesContext allows bulk editing many series at once.
+func (s *Sonarr) EditSeriesContext(ctx context.Context, editSeries *BulkEdit) ([]*Series, error) {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(editSeries); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpSeriesEditor, err)
+	}
+
+	var output []*Series
+
+	req := starr.Request{URI: bpSeriesEditor, Body: &body}
+	if err := s.PutInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Put(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// DeleteMultipleSeries bulk deletes series. Can also add them to the import list exclusions, and delete their files.
+func (s *Sonarr) DeleteMultipleSeries(deleteSeries *BulkEdit) error {
+	return s.DeleteMultipleSeriesContext(context.Background(), deleteSeries)
+}
+
+// DeleteMultipleSeriesContext bulk deletes series. Can also add them to the import list exclusions,
+// and delete their files.
+func (s *Sonarr) DeleteMultipleSeriesContext(ctx context.Context, deleteSeries *BulkEdit) error {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(deleteSeries); err != nil {
+		return fmt.Errorf("json.Marshal(%s): %w", bpSeriesEditor, err)
+	}
+
+	req := starr.Request{URI: bpSeriesEditor, Body: &body}
+	if err := s.DeleteAny(ctx, req); err != nil {
+		return fmt.Errorf("api.Delete(%s): %w", &req, err)
+	}
+
+	return nil
+}
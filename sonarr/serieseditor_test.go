@@ -0,0 +1,111 @@
+package sonarr_test
+
+import (
+	"net/http"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/craigjmidwinter/starr"
+	"github.com/craigjmidwinter/starr/sonarr"
+)
+
+func TestEditSeries(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starr.TestMockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, sonarr.APIver, "series", "editor"),
+			ResponseStatus: http.StatusOK,
+			ResponseBody:   `[{"id": 7, "monitored": true},{"id": 3, "monitored": true}]`,
+			WithError:      nil,
+			WithRequest: &sonarr.BulkEdit{
+				SeriesIDs:    []int64{7, 3},
+				Monitored:    starr.True(),
+				SeasonFolder: starr.False(),
+			},
+			ExpectedRequest: `{"seriesIds":[7,3],"monitored":true,"seasonFolder":false}` + "\n",
+			ExpectedMethod:  http.MethodPut,
+			WithResponse:    []*sonarr.Series{{ID: 7, Monitored: true}, {ID: 3, Monitored: true}},
+		},
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, sonarr.APIver, "series", "editor"),
+			ResponseStatus: http.StatusOK,
+			ResponseBody: `[{"id":17,"qualityProfileId":4,"seriesType":"anime","tags":[44,55]},` +
+				`{"id":13,"qualityProfileId":4,"seriesType":"anime","tags":[44,55]}]`,
+			WithError: nil,
+			WithRequest: &sonarr.BulkEdit{
+				SeriesIDs:        []int64{17, 13},
+				QualityProfileID: starr.Int64(4),
+				SeriesType:       starr.String("anime"),
+				RootFolderPath:   starr.String("/anime"),
+				MoveFiles:        starr.True(),
+				Tags:             []int{44, 55},
+				ApplyTags:        starr.TagsReplace.Ptr(),
+			},
+			ExpectedRequest: `{"seriesIds":[17,13],"qualityProfileId":4,"seriesType":"anime","rootFolderPath":"/anime",` +
+				`"tags":[44,55],"applyTags":"replace","moveFiles":true}` + "\n",
+			ExpectedMethod: http.MethodPut,
+			WithResponse: []*sonarr.Series{
+				{ID: 17, QualityProfileID: 4, SeriesType: "anime", Tags: []int{44, 55}},
+				{ID: 13, QualityProfileID: 4, SeriesType: "anime", Tags: []int{44, 55}},
+			},
+		},
+		{
+			Name:            "404",
+			ExpectedPath:    path.Join("/", starr.API, sonarr.APIver, "series", "editor"),
+			ResponseStatus:  http.StatusNotFound,
+			ResponseBody:    `{"message": "NotFound"}`,
+			WithError:       starr.ErrInvalidStatusCode,
+			WithRequest:     &sonarr.BulkEdit{SeriesIDs: []int64{7}, Monitored: starr.False()},
+			ExpectedRequest: `{"seriesIds":[7],"monitored":false}` + "\n",
+			ExpectedMethod:  http.MethodPut,
+			WithResponse:    []*sonarr.Series(nil),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := sonarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.EditSeries(test.WithRequest.(*sonarr.BulkEdit))
+			assert.ErrorIs(t, err, test.WithError, "the wrong error was returned")
+			assert.EqualValues(t, test.WithResponse, output, "make sure ResponseBody and WithResponse are a match")
+		})
+	}
+}
+
+func TestDeleteMultipleSeries(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starr.TestMockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, sonarr.APIver, "series", "editor"),
+			ResponseStatus: http.StatusOK,
+			WithError:      nil,
+			WithRequest: &sonarr.BulkEdit{
+				SeriesIDs:              []int64{7, 3},
+				DeleteFiles:            starr.True(),
+				AddImportListExclusion: starr.True(),
+			},
+			ExpectedRequest: `{"seriesIds":[7,3],"deleteFiles":true,"addImportListExclusion":true}` + "\n",
+			ExpectedMethod:  http.MethodDelete,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := sonarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			err := client.DeleteMultipleSeries(test.WithRequest.(*sonarr.BulkEdit))
+			assert.ErrorIs(t, err, test.WithError, "the wrong error was returned")
+		})
+	}
+}
@@ -0,0 +1,177 @@
+package sonarr
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strconv"
+	"time"
+
+	"github.com/craigjmidwinter/starr"
+)
+
+const bpWanted = APIver + "/wanted"
+
+// Wanted is the output from the /api/v3/wanted/missing and /api/v3/wanted/cutoff endpoints.
+// Every episode record includes its series.
+type Wanted struct {
+	Page          int        `json:"page"`
+	PageSize      int        `json:"pageSize"`
+	SortKey       string     `json:"sortKey"`
+	SortDirection string     `json:"sortDirection"`
+	TotalRecords  int        `json:"totalRecords"`
+	Records       []*Episode `json:"records"`
+}
+
+// EpisodeIDs returns the IDs for every episode in the wanted list. Useful with SearchEpisodes().
+func (w *Wanted) EpisodeIDs() []int64 {
+	ids := make([]int64, len(w.Records))
+	for idx, episode := range w.Records {
+		ids[idx] = episode.ID
+	}
+
+	return ids
+}
+
+// GetWantedMissing returns aired episodes that do not have a file.
+// Set monitored to false to list unmonitored episodes instead of monitored episodes.
+// This function simply returns the number of records desired, up to the number of records present in
+// the application. It grabs records in (paginated) batches of perPage, and concatenates them into one list.
+// Passing zero for records will return all of them.
+func (s *Sonarr) GetWantedMissing(records, perPage int, monitored bool) (*Wanted, error) {
+	return s.GetWantedMissingContext(context.Background(), records, perPage, monitored)
+}
+
+// GetWantedMissingContext returns aired episodes that do not have a file.
+func (s *Sonarr) GetWantedMissingContext(ctx context.Context, records, perPage int, monitored bool) (*Wanted, error) {
+	return s.getWanted(ctx, "missing", records, perPage, monitored)
+}
+
+// GetWantedMissingPage returns a single page of monitored, aired episodes that do not have a file.
+// The page size and number is configurable with the input request parameters.
+// Set the "monitored" parameter to "false" to list unmonitored episodes instead.
+func (s *Sonarr) GetWantedMissingPage(params *starr.PageReq) (*Wanted, error) {
+	return s.GetWantedMissingPageContext(context.Background(), params)
+}
+
+// GetWantedMissingPageContext returns a single page of monitored, aired episodes that do not have a file.
+func (s *Sonarr) GetWantedMissingPageContext(ctx context.Context, params *starr.PageReq) (*Wanted, error) {
+	return s.getWantedPage(ctx, "missing", params)
+}
+
+// GetWantedCutoff returns episodes with a file that has not met the quality profile cutoff.
+// Set monitored to false to list unmonitored episodes instead of monitored episodes.
+// This function simply returns the number of records desired, up to the number of records present in
+// the application. It grabs records in (paginated) batches of perPage, and concatenates them into one list.
+// Passing zero for records will return all of them.
+func (s *Sonarr) GetWantedCutoff(records, perPage int, monitored bool) (*Wanted, error) {
+	return s.GetWantedCutoffContext(context.Background(), records, perPage, monitored)
+}
+
+// GetWantedCutoffContext returns episodes with a file that has not met the quality profile cutoff.
+func (s *Sonarr) GetWantedCutoffContext(ctx context.Context, records, perPage int, monitored bool) (*Wanted, error) {
+	return s.getWanted(ctx, "cutoff", records, perPage, monitored)
+}
+
+// GetWantedCutoffPage returns a single page of monitored episodes that have not met the quality profile cutoff.
+// The page size and number is configurable with the input request parameters.
+// Set the "monitored" parameter to "false" to list unmonitored episodes instead.
+func (s *Sonarr) GetWantedCutoffPage(params *starr.PageReq) (*Wanted, error) {
+	return s.GetWantedCutoffPageContext(context.Background(), params)
+}
+
+// GetWantedCutoffPageContext returns a single page of monitored episodes that have not met the quality profile cutoff.
+func (s *Sonarr) GetWantedCutoffPageContext(ctx context.Context, params *starr.PageReq) (*Wanted, error) {
+	return s.getWantedPage(ctx, "cutoff", params)
+}
+
+func (s *Sonarr) getWanted(ctx context.Context, list string, records, perPage int, monitored bool) (*Wanted, error) {
+	wanted := &Wanted{Records: []*Episode{}}
+	params := &starr.PageReq{PageSize: perPage}
+	params.Set("monitored", strconv.FormatBool(monitored))
+
+	err := starr.Paginate(ctx, params, records,
+		func(ctx context.Context, params *starr.PageReq) (int, int, error) {
+			curr, err := s.getWantedPage(ctx, list, params)
+			if err != nil {
+				return 0, 0, err
+			}
+
+			wanted.Records = append(wanted.Records, curr.Records...)
+			wanted.PageSize = curr.TotalRecords
+			wanted.TotalRecords = curr.TotalRecords
+			wanted.SortDirection = curr.SortDirection
+			wanted.SortKey = curr.SortKey
+
+			return len(curr.Records), curr.TotalRecords, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	// The last page may return more records than requested.
+	if records > 0 && len(wanted.Records) > records {
+		wanted.Records = wanted.Records[:records]
+	}
+
+	return wanted, nil
+}
+
+func (s *Sonarr) getWantedPage(ctx context.Context, list string, params *starr.PageReq) (*Wanted, error) {
+	var output Wanted
+
+	params.CheckSet("sortKey", "airDateUtc")
+	params.CheckSet("includeSeries", "true")
+	params.CheckSet("monitored", "true")
+
+	req := starr.Request{URI: path.Join(bpWanted, list), Query: params.Params()}
+	if err := s.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// SearchEpisodes sends EpisodeSearch commands for the provided episodes in batches of batchSize.
+// After each command is sent, its status is checked every poll interval with GetCommandStatus,
+// and the next batch is not sent until the previous one has finished. This keeps a large backlog
+// search from flooding indexers. A batchSize of zero sends every episode in a single command, and a
+// poll interval of zero uses DefaultCommandPoll. The final status of every command sent is returned.
+func (s *Sonarr) SearchEpisodes(episodeIDs []int64, batchSize int, poll time.Duration) ([]*CommandResponse, error) {
+	return s.SearchEpisodesContext(context.Background(), episodeIDs, batchSize, poll)
+}
+
+// SearchEpisodesContext sends EpisodeSearch commands for the provided episodes in batches of batchSize,
+// waiting for each command to finish before sending the next. The wait ends early if the context is canceled.
+func (s *Sonarr) SearchEpisodesContext(
+	ctx context.Context,
+	episodeIDs []int64,
+	batchSize int,
+	poll time.Duration,
+) ([]*CommandResponse, error) {
+	if batchSize <= 0 {
+		batchSize = len(episodeIDs)
+	}
+
+	output := []*CommandResponse{}
+
+	for start := 0; start < len(episodeIDs); start += batchSize {
+		end := start + batchSize
+		if end > len(episodeIDs) {
+			end = len(episodeIDs)
+		}
+
+		resp, err := s.SendCommandContext(ctx, &CommandRequest{Name: "EpisodeSearch", EpisodeIDs: episodeIDs[start:end]})
+		if err != nil {
+			return output, err
+		}
+
+		if resp, err = s.waitForCommand(ctx, resp, poll); err != nil {
+			return output, err
+		}
+
+		output = append(output, resp)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,156 @@
+package sonarr_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/craigjmidwinter/starr"
+	"github.com/craigjmidwinter/starr/sonarr"
+)
+
+func TestGetWantedMissingPage(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starr.TestMockData{
+		{
+			Name: "200",
+			ExpectedPath: path.Join("/", starr.API, sonarr.APIver, "wanted", "missing") +
+				"?includeSeries=true&monitored=true&page=2&pageSize=1&sortDirection=ascending&sortKey=airDateUtc",
+			ResponseStatus: http.StatusOK,
+			ResponseBody: `{"page":2,"pageSize":1,"sortKey":"airDateUtc","sortDirection":"ascending","totalRecords":3,` +
+				`"records":[{"id":17,"seriesId":2,"title":"Pilot","monitored":true,"series":{"id":2,"title":"Show"}}]}`,
+			WithRequest:    &starr.PageReq{Page: 2, PageSize: 1},
+			WithError:      nil,
+			ExpectedMethod: http.MethodGet,
+			WithResponse: &sonarr.Wanted{
+				Page:          2,
+				PageSize:      1,
+				SortKey:       "airDateUtc",
+				SortDirection: "ascending",
+				TotalRecords:  3,
+				Records: []*sonarr.Episode{{
+					ID:        17,
+					SeriesID:  2,
+					Title:     "Pilot",
+					Monitored: true,
+					Series:    &sonarr.Series{ID: 2, Title: "Show"},
+				}},
+			},
+		},
+		{
+			Name: "404",
+			ExpectedPath: path.Join("/", starr.API, sonarr.APIver, "wanted", "missing") +
+				"?includeSeries=true&monitored=false&page=1&pageSize=10&sortDirection=descending&sortKey=airDateUtc",
+			ResponseStatus: http.StatusNotFound,
+			ResponseBody:   `{"message": "NotFound"}`,
+			WithRequest: &starr.PageReq{
+				SortDir: starr.SortDescend,
+				Values:  map[string][]string{"monitored": {"false"}},
+			},
+			WithError:      starr.ErrInvalidStatusCode,
+			ExpectedMethod: http.MethodGet,
+			WithResponse:   (*sonarr.Wanted)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := sonarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.GetWantedMissingPage(test.WithRequest.(*starr.PageReq))
+			assert.ErrorIs(t, err, test.WithError, "the wrong error was returned")
+			assert.EqualValues(t, test.WithResponse, output, "make sure ResponseBody and WithResponse are a match")
+		})
+	}
+}
+
+func TestGetWantedCutoff(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, path.Join("/", starr.API, sonarr.APIver, "wanted", "cutoff"), r.URL.Path)
+		assert.Equal(t, "false", r.URL.Query().Get("monitored"))
+		assert.Equal(t, "true", r.URL.Query().Get("includeSeries"))
+
+		page := r.URL.Query().Get("page")
+		fmt.Fprintf(w, `{"page":%s,"totalRecords":4,"records":[{"id":%s0},{"id":%s1}]}`, page, page, page)
+	}))
+	defer server.Close()
+
+	client := sonarr.New(starr.New("mockAPIkey", server.URL, 0))
+	output, err := client.GetWantedCutoff(0, 2, false)
+	require.NoError(t, err)
+	assert.Equal(t, 4, output.TotalRecords)
+	assert.Equal(t, []int64{10, 11, 20, 21}, output.EpisodeIDs())
+}
+
+func TestSearchEpisodes(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mu      sync.Mutex
+		batches [][]int64
+		polls   int
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if r.Method == http.MethodGet {
+			// Each command reports "started" once, then "completed".
+			polls++
+			status := sonarr.CommandStarted
+			if polls%2 == 0 {
+				status = sonarr.CommandCompleted
+			}
+
+			id := strings.TrimPrefix(r.URL.Path, path.Join("/", starr.API, sonarr.APIver, "command")+"/")
+			fmt.Fprintf(w, `{"id":%s,"name":"EpisodeSearch","status":%q}`, id, status)
+
+			return
+		}
+
+		var cmd sonarr.CommandRequest
+
+		assert.Equal(t, path.Join("/", starr.API, sonarr.APIver, "command"), r.URL.Path)
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&cmd))
+		assert.Equal(t, "EpisodeSearch", cmd.Name)
+		assert.Equal(t, len(batches), polls/2, "a batch must not be sent before the previous one finishes")
+
+		batches = append(batches, cmd.EpisodeIDs)
+		fmt.Fprintf(w, `{"id":%d,"name":"EpisodeSearch","status":"queued"}`, len(batches))
+	}))
+	defer server.Close()
+
+	client := sonarr.New(starr.New("mockAPIkey", server.URL, 0))
+	output, err := client.SearchEpisodes([]int64{1, 2, 3, 4, 5}, 2, time.Millisecond)
+	require.NoError(t, err)
+	require.Len(t, output, 3, "one response should be returned for every batch")
+	assert.Equal(t, [][]int64{{1, 2}, {3, 4}, {5}}, batches)
+	assert.Equal(t, 6, polls, "every command should be polled until it completes")
+
+	for idx, cmd := range output {
+		assert.EqualValues(t, idx+1, cmd.ID)
+		assert.Equal(t, sonarr.CommandCompleted, cmd.Status, "the final status must be returned")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	output, err = client.SearchEpisodesContext(ctx, []int64{1, 2, 3}, 1, time.Hour)
+	assert.ErrorIs(t, err, context.DeadlineExceeded, "waiting must stop when the context is canceled")
+	assert.Empty(t, output, "the unfinished command is not returned")
+}
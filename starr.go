@@ -45,6 +45,8 @@ var (
 // At a minimum, provide a URL and API Key.
 // HTTPUser and HTTPPass are used for Basic HTTP auth, if enabled (not common).
 // Username and Password are for non-API paths with native authentication enabled.
+// Retry is optional; set it to automatically retry requests that fail for transient reasons.
+// Limit is optional; set it to cap the request rate and concurrency for this Config.
 type Config struct {
 	APIKey   string       `json:"apiKey" toml:"api_key" xml:"api_key" yaml:"apiKey"`
 	URL      string       `json:"url" toml:"url" xml:"url" yaml:"url"`
@@ -53,6 +55,8 @@ type Config struct {
 	Username string       `json:"username" toml:"username" xml:"username" yaml:"username"`
 	Password string       `json:"password" toml:"password" xml:"password" yaml:"password"`
 	Client   *http.Client `json:"-" toml:"-" xml:"-" yaml:"-"`
+	Retry    *Retry       `json:"-" toml:"-" xml:"-" yaml:"-"`
+	Limit    *RateLimit   `json:"-" toml:"-" xml:"-" yaml:"-"`
 	cookie   bool         // this probably doesn't work right.
 }
 